@@ -2,5 +2,6 @@ package api
 
 import (
 	_ "project-api/api/project"
+	_ "project-api/api/swagger"
 	_ "project-api/api/user"
 )
@@ -0,0 +1,30 @@
+package midd
+
+import (
+	"github.com/gin-gonic/gin"
+	"project-api/config"
+	"project-common/discovery"
+	"slices"
+	"strings"
+)
+
+// Canary 返回一个中间件函数，携带灰度请求头（值为 true）或配置的灰度成员发起的请求标记为灰度请求，
+// 标记保存在调用服务的 gRPC metadata 中，网关的负载均衡按标记把调用转发到各服务的灰度版本。
+// 处理函数需要使用 c.Request.Context() 调用服务，需要放在 TokenVerify 之后
+func Canary() func(*gin.Context) {
+	return func(c *gin.Context) {
+		if canaryRequest(c, config.C.Canary) {
+			c.Request = c.Request.WithContext(discovery.WithCanary(c.Request.Context()))
+		}
+		c.Next()
+	}
+}
+
+// canaryRequest 判断请求是否为灰度请求
+func canaryRequest(c *gin.Context, cc *config.CanaryConfig) bool {
+	if cc.Header != "" && strings.EqualFold(c.GetHeader(cc.Header), "true") {
+		return true
+	}
+	memberId := c.GetInt64("memberId")
+	return memberId != 0 && slices.Contains(cc.Members, memberId)
+}
@@ -0,0 +1,195 @@
+package midd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"io"
+	"mime"
+	"net/http"
+	"project-api/config"
+	"project-api/pkg/dao"
+	common "project-common"
+	"strconv"
+	"time"
+)
+
+const (
+	// idempotencyHeader 客户端携带幂等键的请求头
+	idempotencyHeader = "Idempotency-Key"
+	// idempotencyReplayedHeader 返回保存的首次响应时带上的响应头
+	idempotencyReplayedHeader = "Idempotent-Replayed"
+	// idempotencyKey 幂等记录的redis key前缀
+	idempotencyKey = "IDEMPOTENCY::"
+	// idempotencyLockTTL 首次请求处理期间占用幂等键的时间，网关异常退出时最迟在该时间后释放
+	idempotencyLockTTL = time.Minute
+	// idempotencyMaxKeyLen 幂等键的最大长度
+	idempotencyMaxKeyLen = 255
+)
+
+// idempotencyReplayHeaders 重试时随首次响应一起返回的响应头
+var idempotencyReplayHeaders = []string{"Content-Type", "Location"}
+
+// idempotencyRecord 保存在redis中的幂等记录，Status 为 0 表示首次请求仍在处理中
+type idempotencyRecord struct {
+	Fingerprint string            `json:"fingerprint"`
+	Status      int               `json:"status,omitempty"`
+	Header      map[string]string `json:"header,omitempty"`
+	Body        []byte            `json:"body,omitempty"`
+}
+
+// idempotencyWriter 记录首次请求的响应体
+type idempotencyWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *idempotencyWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *idempotencyWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Idempotency 返回一个中间件函数，写接口携带 Idempotency-Key 请求头时可以安全地重试。
+// 同一成员相同幂等键的首次响应在redis中保存一段时间，重试时直接返回保存的响应，不再调用服务；
+// 相同的幂等键用于不同的请求（方法、路径或请求体不同），或首次请求仍在处理中时返回 409。
+// 首次请求失败（HTTP 5xx 或 v1 接口返回系统错误码）时不保存响应，重试会重新处理。
+// 需要放在 TokenVerify 之后，redis不可用时按普通请求处理
+func Idempotency() func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.GetHeader(idempotencyHeader)
+		if !config.C.Idempotency.Enabled || key == "" || c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+		if len(key) > idempotencyMaxKeyLen {
+			Abort(c, http.StatusBadRequest, "幂等键过长")
+			return
+		}
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			Abort(c, http.StatusBadRequest, "读取请求失败")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		fingerprint := requestFingerprint(c.Request, body)
+		redisKey := idempotencyKey + strconv.FormatInt(c.GetInt64("memberId"), 10) + "::" + key
+
+		lock, _ := json.Marshal(&idempotencyRecord{Fingerprint: fingerprint})
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		first, err := dao.Rc.PutNX(ctx, redisKey, string(lock), idempotencyLockTTL)
+		if err != nil {
+			zap.L().Error("Idempotency redis error", zap.Error(err))
+			c.Next()
+			return
+		}
+		if !first {
+			replayIdempotent(c, redisKey, fingerprint)
+			return
+		}
+
+		w := &idempotencyWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if !idempotentSucceeded(c, w.body.Bytes()) {
+			if err := dao.Rc.Del(ctx, redisKey); err != nil {
+				zap.L().Error("Idempotency redis error", zap.Error(err))
+			}
+			return
+		}
+		record := &idempotencyRecord{
+			Fingerprint: fingerprint,
+			Status:      w.Status(),
+			Header:      map[string]string{},
+			Body:        w.body.Bytes(),
+		}
+		for _, h := range idempotencyReplayHeaders {
+			if v := w.Header().Get(h); v != "" {
+				record.Header[h] = v
+			}
+		}
+		val, _ := json.Marshal(record)
+		if err := dao.Rc.Put(ctx, redisKey, string(val), config.C.Idempotency.TTL); err != nil {
+			zap.L().Error("Idempotency redis error", zap.Error(err))
+		}
+	}
+}
+
+// replayIdempotent 处理携带已使用的幂等键的请求，返回保存的首次响应
+func replayIdempotent(c *gin.Context, redisKey, fingerprint string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	val, err := dao.Rc.Get(ctx, redisKey)
+	if errors.Is(err, redis.Nil) {
+		// 首次请求失败后刚刚释放了幂等键，客户端重试时会重新处理
+		Abort(c, http.StatusConflict, "相同幂等键的请求正在处理中，请稍后重试")
+		return
+	}
+	if err != nil {
+		zap.L().Error("Idempotency redis error", zap.Error(err))
+		c.Next()
+		return
+	}
+	record := &idempotencyRecord{}
+	if err := json.Unmarshal([]byte(val), record); err != nil {
+		zap.L().Error("Idempotency record error", zap.String("key", redisKey), zap.Error(err))
+		c.Next()
+		return
+	}
+	if record.Fingerprint != fingerprint {
+		Abort(c, http.StatusConflict, "幂等键已用于其他请求")
+		return
+	}
+	if record.Status == 0 {
+		Abort(c, http.StatusConflict, "相同幂等键的请求正在处理中，请稍后重试")
+		return
+	}
+	for h, v := range record.Header {
+		c.Header(h, v)
+	}
+	c.Header(idempotencyReplayedHeader, "true")
+	c.Data(record.Status, record.Header["Content-Type"], record.Body)
+	c.Abort()
+}
+
+// requestFingerprint 计算请求的指纹，用于判断重试的请求与首次请求是否相同。
+// multipart 请求每次的分隔符是随机的，计算前先去掉分隔符
+func requestFingerprint(r *http.Request, body []byte) string {
+	if _, params, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil && params["boundary"] != "" {
+		body = bytes.ReplaceAll(body, []byte(params["boundary"]), nil)
+	}
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.RequestURI()+"\n")
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// idempotentSucceeded 判断首次请求是否处理成功，只有成功的请求才保存响应。
+// v1 接口的 HTTP 状态码固定为 200，按响应中的业务码判断
+func idempotentSucceeded(c *gin.Context, body []byte) bool {
+	if c.Writer.Status() >= http.StatusInternalServerError {
+		return false
+	}
+	if c.GetBool(restApiKey) {
+		return true
+	}
+	result := &common.Result{}
+	if err := json.Unmarshal(body, result); err != nil || result.Code == 0 {
+		return true
+	}
+	return result.Code == 200 || HttpStatus(result.Code) < http.StatusInternalServerError
+}
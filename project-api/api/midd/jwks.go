@@ -0,0 +1,111 @@
+package midd
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"project-api/api/rpc"
+	"project-common/jwts"
+	"project-grpc/user/login"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksRefreshInterval 定时刷新公钥的间隔，用户服务轮换密钥后网关最迟在该间隔后拿到新公钥
+	jwksRefreshInterval = 10 * time.Minute
+	// jwksMinInterval 遇到未知 kid 时立即刷新，但两次刷新之间至少间隔该时间，防止伪造的 kid 打满用户服务
+	jwksMinInterval = 30 * time.Second
+)
+
+// jwks 网关持有的访问令牌公钥，只能校验令牌不能签发令牌
+var jwks = &jwksCache{}
+
+type jwksCache struct {
+	// refreshing 同一时间只有一个请求去刷新公钥，其他请求继续使用当前的公钥
+	refreshing sync.Mutex
+	mu         sync.RWMutex
+	keySet     *jwts.KeySet
+	doc        []byte
+	fetched    time.Time
+}
+
+// InitJwks 从用户服务获取访问令牌公钥并定时刷新，需要在用户服务的gRPC客户端初始化之后调用
+func InitJwks() {
+	if err := jwks.refresh(); err != nil {
+		zap.L().Error("InitJwks refresh error", zap.Error(err))
+	}
+	go func() {
+		for range time.Tick(jwksRefreshInterval) {
+			if err := jwks.refresh(); err != nil {
+				zap.L().Error("Jwks refresh error", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// refresh 通过用户服务的 Jwks 接口重新获取公钥
+func (j *jwksCache) refresh() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	rsp, err := rpc.LoginServiceClient.Jwks(ctx, &login.JwksMessage{})
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.fetched = time.Now()
+	if err != nil {
+		return err
+	}
+	ks, err := jwts.ParseJWKS([]byte(rsp.Jwks))
+	if err != nil {
+		return err
+	}
+	j.keySet = ks
+	j.doc = []byte(rsp.Jwks)
+	return nil
+}
+
+// get 返回当前的公钥，kid 不在其中时按最小间隔刷新一次
+func (j *jwksCache) get(kid string) *jwts.KeySet {
+	j.mu.RLock()
+	ks, fetched := j.keySet, j.fetched
+	j.mu.RUnlock()
+	if ks != nil && ks.Has(kid) || time.Since(fetched) <= jwksMinInterval || !j.refreshing.TryLock() {
+		return ks
+	}
+	defer j.refreshing.Unlock()
+	if err := j.refresh(); err != nil {
+		zap.L().Error("Jwks refresh error", zap.Error(err))
+	}
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.keySet
+}
+
+// parseJwt 在网关本地校验登录令牌的签名和有效期，返回令牌中携带的声明。
+// 公钥还没有获取到时返回 nil, true，交给用户服务校验，避免用户服务短暂不可用时网关拒绝所有请求。
+func parseJwt(token string) (*jwts.TokenClaims, bool) {
+	token = strings.TrimPrefix(token, "bearer ")
+	ks := jwks.get(jwts.KeyId(token))
+	if ks == nil {
+		return nil, true
+	}
+	claims, err := jwts.ParseClaimsWithKeySet(token, ks)
+	if err != nil {
+		return nil, false
+	}
+	return claims, true
+}
+
+// Jwks 对外发布访问令牌的公钥（JWKS文档），供其他需要校验令牌的服务使用
+func Jwks(c *gin.Context) {
+	jwks.mu.RLock()
+	doc := jwks.doc
+	jwks.mu.RUnlock()
+	if doc == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"keys": []any{}})
+		return
+	}
+	c.Data(http.StatusOK, "application/json", doc)
+}
@@ -3,7 +3,7 @@ package midd
 import (
 	"fmt"
 	"github.com/gin-gonic/gin"
-	"go.uber.org/zap"
+	"project-common/logs"
 	"time"
 )
 
@@ -21,6 +21,7 @@ func RequestLog() func(*gin.Context) {
 		diff := time.Now().UnixMilli() - start.UnixMilli()
 		// 使用zap日志库记录请求URI和耗时信息。
 		// 选择zap是因为其高性能和结构化日志的特点，适合在生产环境中使用。
-		zap.L().Info(fmt.Sprintf("%s 用时 %d ms", c.Request.RequestURI, diff))
+		// 请求上下文中带有 Trace 中间件生成的 traceId
+		logs.Ctx(c.Request.Context()).Info(fmt.Sprintf("%s 用时 %d ms", c.Request.RequestURI, diff))
 	}
 }
@@ -0,0 +1,141 @@
+package midd
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"project-api/pkg/dao"
+	"project-common/jwts"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// memberCacheTTL 令牌校验结果在网关缓存的时间，收不到失效通知时最迟在该时间后重新校验
+	memberCacheTTL = time.Minute
+	// authInvalidateChannel 用户服务发布失效通知的频道，与用户服务保持一致
+	authInvalidateChannel = "AUTH_INVALIDATE"
+)
+
+// members 网关本地的令牌校验缓存，按访问令牌的 jti 缓存成员和组织信息
+var members = &memberCache{
+	entries:     make(map[string]*memberEntry),
+	byMember:    make(map[int64]map[string]struct{}),
+	invalidated: make(map[int64]*invalidation),
+}
+
+type memberEntry struct {
+	memberId         int64
+	name             string
+	organizationCode string
+	expire           time.Time
+}
+
+// invalidation 成员最近一次失效时的序号和时间
+type invalidation struct {
+	gen uint64
+	at  time.Time
+}
+
+type memberCache struct {
+	mu       sync.RWMutex
+	entries  map[string]*memberEntry
+	byMember map[int64]map[string]struct{}
+	// gen 每次失效都会递增，invalidated 记录每个成员最近一次失效时的序号。
+	// 校验期间该成员发生过失效时不写入缓存，避免写入失效前查到的旧数据，其他成员的写入不受影响
+	gen         uint64
+	invalidated map[int64]*invalidation
+}
+
+// InitMemberCache 订阅用户服务的失效通知，成员信息、组织或令牌吊销发生变化时丢弃该成员的缓存
+func InitMemberCache() {
+	pubsub := dao.Rc.Subscribe(context.Background(), authInvalidateChannel)
+	go func() {
+		for msg := range pubsub.Channel() {
+			memId, err := strconv.ParseInt(msg.Payload, 10, 64)
+			if err != nil {
+				zap.L().Error("member cache invalidate payload error", zap.String("payload", msg.Payload))
+				continue
+			}
+			members.invalidate(memId)
+		}
+	}()
+	go func() {
+		for range time.Tick(memberCacheTTL) {
+			members.sweep()
+		}
+	}()
+}
+
+// get 查询令牌的缓存，不存在或已过期时返回 nil
+func (m *memberCache) get(jti string) *memberEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	e := m.entries[jti]
+	if e == nil || time.Now().After(e.expire) {
+		return nil
+	}
+	return e
+}
+
+// generation 返回当前的失效序号，在调用用户服务校验之前获取
+func (m *memberCache) generation() uint64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.gen
+}
+
+// put 缓存令牌的校验结果，有效期不超过令牌本身的过期时间
+func (m *memberCache) put(claims *jwts.TokenClaims, gen uint64, e *memberEntry) {
+	if claims.Jti == "" {
+		return
+	}
+	e.expire = time.Now().Add(memberCacheTTL)
+	if exp := time.Unix(claims.Exp, 0); exp.Before(e.expire) {
+		e.expire = exp
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if inv := m.invalidated[e.memberId]; inv != nil && inv.gen > gen {
+		return
+	}
+	m.entries[claims.Jti] = e
+	if m.byMember[e.memberId] == nil {
+		m.byMember[e.memberId] = make(map[string]struct{})
+	}
+	m.byMember[e.memberId][claims.Jti] = struct{}{}
+}
+
+// invalidate 丢弃成员的全部缓存
+func (m *memberCache) invalidate(memId int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gen++
+	m.invalidated[memId] = &invalidation{gen: m.gen, at: time.Now()}
+	for jti := range m.byMember[memId] {
+		delete(m.entries, jti)
+	}
+	delete(m.byMember, memId)
+}
+
+// sweep 清理过期的缓存和过期的失效记录。
+// 失效记录只用于拦截正在进行的校验，校验不会超过缓存有效期，之后可以丢弃
+func (m *memberCache) sweep() {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for jti, e := range m.entries {
+		if now.After(e.expire) {
+			delete(m.entries, jti)
+			delete(m.byMember[e.memberId], jti)
+			if len(m.byMember[e.memberId]) == 0 {
+				delete(m.byMember, e.memberId)
+			}
+		}
+	}
+	for memId, inv := range m.invalidated {
+		if now.Sub(inv.at) > memberCacheTTL {
+			delete(m.invalidated, memId)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package midd
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"strconv"
+	"time"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "msproject",
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "网关处理的HTTP请求数",
+	}, []string{"route", "method", "status"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "msproject",
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "网关处理HTTP请求的耗时",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method"})
+)
+
+// Metrics 返回一个中间件函数，按路由记录请求数和耗时。
+// 路由使用注册时的路径（如 /project/task/save），未匹配到路由的请求统一记为 unmatched，避免标签数量无限增长
+func Metrics() func(*gin.Context) {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		httpRequests.WithLabelValues(route, c.Request.Method, strconv.Itoa(c.Writer.Status())).Inc()
+		httpDuration.WithLabelValues(route, c.Request.Method).Observe(time.Since(start).Seconds())
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"project-api/api/rpc"
 	common "project-common"
 	"project-common/errs"
+	"project-common/jwts"
 	"project-grpc/user/login"
+	"strings"
 	"time"
 )
 
@@ -33,7 +35,7 @@ func TokenVerifyOld() func(*gin.Context) {
 
 		// 2. 调用user服务进行Token认证
 		// 创建一个带有超时的context，以防止请求等待时间过长
-		ctx, cancelFunc := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancelFunc := context.WithTimeout(c.Request.Context(), 10*time.Second)
 		defer cancelFunc() // 确保在函数退出时取消context
 
 		// 调用RPC服务进行Token验证
@@ -61,15 +63,34 @@ func TokenVerifyOld() func(*gin.Context) {
 func TokenVerify() func(*gin.Context) {
 	// 返回一个闭包函数，处理实际的Token验证逻辑
 	return func(c *gin.Context) {
-		// 初始化一个通用的结果对象，用于后续可能的响应
-		result := &common.Result{}
-
 		// 1. 从请求的header中获取Token
 		token := c.GetHeader("Authorization")
 
+		// 登录令牌先在网关本地用公钥校验签名，伪造或过期的令牌不再请求用户服务；
+		// 校验结果命中本地缓存时直接放行，只有缓存未命中时才调用用户服务
+		var claims *jwts.TokenClaims
+		if !strings.HasPrefix(token, patScheme) {
+			var ok bool
+			claims, ok = parseJwt(token)
+			if !ok {
+				Abort(c, noLoginCode, "未登录")
+				return
+			}
+			if claims != nil {
+				if e := members.get(claims.Jti); e != nil {
+					c.Set("memberId", e.memberId)
+					c.Set("memberName", e.name)
+					c.Set("organizationCode", e.organizationCode)
+					c.Next()
+					return
+				}
+			}
+		}
+		gen := members.generation()
+
 		// 2. 调用user服务进行Token认证
 		// 创建一个带有超时的context，以防止请求等待时间过长
-		ctx, cancelFunc := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancelFunc := context.WithTimeout(c.Request.Context(), 10*time.Second)
 		defer cancelFunc() // 确保在函数退出时取消context
 		// TODO 将Ip加入Token中，保证Token的安全性
 		ip := GetIp(c)
@@ -80,9 +101,7 @@ func TokenVerify() func(*gin.Context) {
 		response, err := rpc.LoginServiceClient.TokenVerify(ctx, req)
 		if err != nil {
 			// 如果发生错误，解析gRPC错误并返回相应的错误信息
-			code, msg := errs.ParseGrpcError(err)
-			c.JSON(http.StatusOK, result.Fail(code, msg))
-			c.Abort() // 中止后续的路由处理
+			AbortError(c, err)
 			return
 		}
 
@@ -92,7 +111,93 @@ func TokenVerify() func(*gin.Context) {
 		c.Set("memberId", response.Member.Id)
 		c.Set("memberName", response.Member.Name)
 		c.Set("organizationCode", response.Member.OrganizationCode)
+		if claims != nil {
+			members.put(claims, gen, &memberEntry{
+				memberId:         response.Member.Id,
+				name:             response.Member.Name,
+				organizationCode: response.Member.OrganizationCode,
+			})
+		}
+		// 使用个人访问令牌时，只能访问令牌授权范围内的接口
+		if strings.HasPrefix(token, patScheme) {
+			if !scopeAllowed(c.Request.URL.Path, response.Scopes) {
+				Abort(c, http.StatusForbidden, "个人访问令牌无权访问该接口")
+				return
+			}
+			c.Set("scopes", response.Scopes)
+		}
 
 		c.Next() // 继续执行后续的路由处理函数
 	}
 }
+
+// accessTokenParam 无法设置请求头时携带 Token 的查询参数
+const accessTokenParam = "access_token"
+
+// QueryToken 返回一个中间件函数，请求头中没有 Token 时使用查询参数 access_token，
+// 用于浏览器的 EventSource 等无法设置请求头的客户端，需要放在 TokenVerify 之前。
+// 读取后从请求地址中去掉该参数，避免 Token 出现在请求日志中
+func QueryToken() func(*gin.Context) {
+	return func(c *gin.Context) {
+		query := c.Request.URL.Query()
+		if token := query.Get(accessTokenParam); token != "" {
+			if c.GetHeader("Authorization") == "" {
+				c.Request.Header.Set("Authorization", token)
+			}
+			query.Del(accessTokenParam)
+			c.Request.URL.RawQuery = query.Encode()
+			c.Request.RequestURI = c.Request.URL.RequestURI()
+		}
+		c.Next()
+	}
+}
+
+// patScheme 个人访问令牌的请求头前缀
+const patScheme = "token "
+
+// noLoginCode 未登录的错误码，与用户服务返回的错误码保持一致
+const noLoginCode = 997
+
+// scopeModules 接口模块（/project/ 后的第一段路径）与授权范围的对应关系，
+// 不在表中的模块（令牌管理、两步验证等）不允许使用个人访问令牌访问
+var scopeModules = map[string]string{
+	"index":            "project",
+	"project":          "project",
+	"project_template": "project",
+	"project_collect":  "project",
+	"project_member":   "project",
+	"task":             "task",
+	"task_stages":      "task",
+	"task_member":      "task",
+	"file":             "task",
+	"account":          "account",
+	"department":       "account",
+	"auth":             "account",
+	"menu":             "account",
+	"organization":     "organization",
+}
+
+// restScopeModules REST 接口（/api/v2/ 后的第一段路径）与授权范围的对应关系
+var restScopeModules = map[string]string{
+	"projects": "project",
+	"tasks":    "task",
+}
+
+// scopeAllowed 判断授权范围是否包含请求的接口
+func scopeAllowed(path string, scopes []string) bool {
+	modules := scopeModules
+	if rest, ok := strings.CutPrefix(path, "/api/v2/"); ok {
+		path, modules = rest, restScopeModules
+	}
+	module, _, _ := strings.Cut(strings.TrimPrefix(path, "/project/"), "/")
+	scope, ok := modules[module]
+	if !ok {
+		return false
+	}
+	for _, s := range scopes {
+		if s == "*" || s == scope {
+			return true
+		}
+	}
+	return false
+}
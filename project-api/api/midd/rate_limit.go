@@ -0,0 +1,69 @@
+package midd
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"math"
+	"net/http"
+	"project-api/config"
+	"project-api/pkg/dao"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateLimitKey 限流计数的redis key前缀
+const rateLimitKey = "RATE_LIMIT::"
+
+// RateLimit 返回一个基于redis滑动窗口的限流中间件，多个网关实例共享同一份计数。
+// 登录后的接口按 memberId 计数，需要放在 TokenVerify 之后；未登录的接口（如 /project/login/*）按IP计数。
+// 单独配置了限额的接口各自计数，其他接口共用默认限额。redis不可用时不限流。
+func RateLimit() func(*gin.Context) {
+	return func(c *gin.Context) {
+		rc := config.C.RateLimit
+		if !rc.Enabled {
+			c.Next()
+			return
+		}
+		path, limit, window := rateLimitRule(rc, c.FullPath())
+		subject := "ip:" + GetIp(c)
+		if memberId := c.GetInt64("memberId"); memberId != 0 {
+			subject = "member:" + strconv.FormatInt(memberId, 10)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		allowed, remaining, reset, err := dao.Rc.SlidingWindow(ctx, rateLimitKey+path+"::"+subject, limit, window)
+		if err != nil {
+			zap.L().Error("RateLimit redis error", zap.Error(err))
+			c.Next()
+			return
+		}
+		resetSeconds := strconv.Itoa(int(math.Ceil(reset.Seconds())))
+		c.Header("X-RateLimit-Limit", strconv.Itoa(limit))
+		c.Header("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+		c.Header("X-RateLimit-Reset", resetSeconds)
+		if !allowed {
+			c.Header("Retry-After", resetSeconds)
+			Abort(c, http.StatusTooManyRequests, "请求过于频繁，请稍后再试")
+			return
+		}
+		c.Next()
+	}
+}
+
+// rateLimitRule 查找接口的限额，返回计数使用的路径、窗口内允许的请求数和窗口。
+// 精确匹配优先于前缀匹配，都没有匹配时使用默认限额，计数路径为 *
+func rateLimitRule(rc *config.RateLimitConfig, fullPath string) (string, int, time.Duration) {
+	for _, r := range rc.Routes {
+		if r.Path == fullPath {
+			return r.Path, r.Limit, r.Window
+		}
+	}
+	for _, r := range rc.Routes {
+		if prefix, ok := strings.CutSuffix(r.Path, "*"); ok && strings.HasPrefix(fullPath, prefix) {
+			return r.Path, r.Limit, r.Window
+		}
+	}
+	return "*", rc.Limit, rc.Window
+}
@@ -0,0 +1,89 @@
+package midd
+
+import (
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"net/http"
+	common "project-common"
+	"project-common/errs"
+)
+
+// restApiKey 标记请求属于 REST 风格的接口（/api/v2）
+const restApiKey = "restApi"
+
+// restStatus 业务码对应的 HTTP 状态码，与用户服务和项目服务的错误码保持一致
+var restStatus = map[common.BusinessCode]int{
+	401:         http.StatusBadRequest, // 项目服务的参数错误
+	noLoginCode: http.StatusUnauthorized,
+	998:         http.StatusInternalServerError, // db错误
+	999:         http.StatusInternalServerError, // redis错误
+	10102014:    http.StatusNotFound,            // 账号不存在
+	10102022:    http.StatusNotFound,            // 访问令牌不存在
+	10102025:    http.StatusForbidden,           // 没有操作权限
+	10102026:    http.StatusForbidden,           // 不是该组织的成员
+	10102034:    http.StatusNotFound,            // 会话不存在或已失效
+	20102002:    http.StatusNotFound,            // 任务步骤不存在
+	20102003:    http.StatusGone,                // 项目已经删除了
+	20102005:    http.StatusForbidden,           // 无权修改该成员的项目角色
+	20102006:    http.StatusForbidden,           // 不是项目成员
+	20102007:    http.StatusNotFound,            // 任务不存在
+	20102008:    http.StatusForbidden,           // 不是该组织的成员
+}
+
+// grpcStatus gRPC 状态码对应的 HTTP 状态码，服务不可用、超时等错误由 gRPC 直接返回
+var grpcStatus = map[codes.Code]int{
+	codes.Canceled:           499,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// RestApi 返回一个中间件函数，标记 REST 风格的接口，失败时返回业务码对应的 HTTP 状态码
+func RestApi() func(*gin.Context) {
+	return func(c *gin.Context) {
+		c.Set(restApiKey, true)
+		c.Next()
+	}
+}
+
+// Abort 中止请求并返回失败的结果。
+// v1 接口的 HTTP 状态码固定为 200，由业务码区分结果；REST 接口返回业务码对应的 HTTP 状态码
+func Abort(c *gin.Context, code common.BusinessCode, msg string) {
+	result := &common.Result{}
+	status := http.StatusOK
+	if c.GetBool(restApiKey) {
+		status = HttpStatus(code)
+	}
+	c.AbortWithStatusJSON(status, result.Fail(code, msg))
+}
+
+// AbortError 解析 gRPC 错误，中止请求并返回失败的结果
+func AbortError(c *gin.Context, err error) {
+	code, msg := errs.ParseGrpcError(err)
+	Abort(c, code, msg)
+}
+
+// HttpStatus 返回业务码对应的 HTTP 状态码：
+// 网关使用的 4xx、5xx 业务码原样返回，gRPC 状态码按含义转换，其他业务错误返回 400
+func HttpStatus(code common.BusinessCode) int {
+	if status, ok := restStatus[code]; ok {
+		return status
+	}
+	if code >= 400 && code < 600 {
+		return int(code)
+	}
+	if code > 0 && code <= 16 {
+		if status, ok := grpcStatus[codes.Code(code)]; ok {
+			return status
+		}
+		return http.StatusInternalServerError
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,47 @@
+package midd
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"project-common/tracing"
+)
+
+// traceIdHeader 返回给客户端的 traceId 响应头，排查问题时可以用它关联网关和各服务的日志
+const traceIdHeader = "X-Trace-Id"
+
+// Trace 返回一个中间件函数，为每个请求生成 trace。
+// 请求头中带有 W3C traceparent 时沿用调用方的 trace，否则在网关生成新的 traceId；
+// trace 保存在 c.Request.Context() 中，调用 gRPC 服务时使用该上下文即可把 trace 传递下去。
+func Trace() func(*gin.Context) {
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+		ctx, span := tracing.Tracer().Start(ctx, c.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("http.route", route),
+				attribute.String("client.address", GetIp(c)),
+			),
+		)
+		defer span.End()
+		c.Request = c.Request.WithContext(ctx)
+		c.Header(traceIdHeader, span.SpanContext().TraceID().String())
+		c.Next()
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, "")
+		}
+		if memberId := c.GetInt64("memberId"); memberId != 0 {
+			span.SetAttributes(attribute.Int64("member.id", memberId))
+		}
+	}
+}
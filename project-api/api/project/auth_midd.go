@@ -1,57 +1,192 @@
 package project
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"net/http"
-	common "project-common"
+	"project-api/api/midd"
+	"project-api/config"
+	"project-api/pkg/dao"
 	"project-common/errs"
+	"project-grpc/auth"
+	"project-grpc/project"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
-var ignores = []string{
-	"project/login/register",
-	"project/login",
-	"project/login/getCaptcha",
-	"project/organization",
-	"project/auth/apply"}
+const (
+	// nodeRulesTTL 权限节点表的缓存时间，节点表只在发版时变化
+	nodeRulesTTL = 5 * time.Minute
+	// authGrantTTL 成员权限节点的缓存时间，角色的权限修改后最迟在该时间后生效
+	authGrantTTL = time.Minute
+	// authGrantMaxEntries 缓存条目达到该数量时清理一次过期的条目
+	authGrantMaxEntries = 10000
+	// authGrantChannel 项目服务发布角色权限节点变化的频道，与项目服务保持一致
+	authGrantChannel = "AUTH_GRANT"
+)
 
-// Auth 返回一个中间件函数，用于验证请求的授权状态
-// 如果请求的URI在忽略列表中，或者匹配到用户的授权节点，则放行请求
-// 否则，返回403错误，表示无权限操作
+// Auth 返回一个中间件函数，按 project_node 中的权限节点校验接口权限。
+// 每个路由对应一个节点（如 /project/task/save 对应 project/task/save，/project/account 对应 project/account 或 project/account/index），
+// 节点标记为需要授权（IsAuth）时，成员当前组织的角色必须拥有该节点，否则返回403；
+// 不在节点表中或不需要授权的接口直接放行。组织的拥有者是否不受限制由配置 auth.ownerUnrestricted 决定。
 func Auth() func(*gin.Context) {
 	return func(c *gin.Context) {
-		result := &common.Result{}
-		uri := c.Request.RequestURI
-
-		// 检查请求URI是否在忽略列表中
-		for _, v := range ignores {
-			if strings.Contains(uri, v) {
-				c.Next()
+		node, isAuth, err := nodeRules.lookup(routePath(c))
+		if err != nil {
+			midd.AbortError(c, err)
+			return
+		}
+		if !isAuth {
+			c.Next()
+			return
+		}
+		grant, err := authGrants.get(c.GetInt64("memberId"), c.GetString("organizationCode"))
+		if err != nil {
+			// 不是该组织的成员时与没有权限一样返回403
+			if code, msg := errs.ParseGrpcError(err); midd.HttpStatus(code) == http.StatusForbidden {
+				midd.Abort(c, http.StatusForbidden, msg)
 				return
 			}
+			midd.AbortError(c, err)
+			return
 		}
-
-		// 判断此uri是否在用户的授权列表中
-		a := NewAuth()
-		nodes, err := a.GetAuthNodes(c)
-		if err != nil {
-			code, msg := errs.ParseGrpcError(err)
-			c.JSON(http.StatusOK, result.Fail(code, msg))
-			c.Abort()
+		if grant.isOwner && config.C.AuthConfig.OwnerUnrestricted {
+			c.Next()
+			return
+		}
+		if _, ok := grant.nodes[node]; ok {
+			c.Next()
 			return
 		}
+		midd.Abort(c, http.StatusForbidden, "无权限操作")
+	}
+}
 
-		// 检查请求URI是否匹配用户的授权节点
-		for _, v := range nodes {
-			if strings.Contains(uri, v) {
-				c.Next()
-				return
-			}
+// nodeRules 权限节点表的缓存，节点 -> 是否需要授权
+var nodeRules = &nodeRuleCache{}
+
+type nodeRuleCache struct {
+	mu     sync.RWMutex
+	nodes  map[string]bool
+	expire time.Time
+}
+
+// lookup 查找路由对应的权限节点，返回节点和节点是否需要授权
+func (n *nodeRuleCache) lookup(path string) (string, bool, error) {
+	nodes, err := n.load()
+	if err != nil {
+		return "", false, err
+	}
+	p := strings.TrimPrefix(path, "/")
+	for _, node := range []string{p, p + "/index"} {
+		if isAuth, ok := nodes[node]; ok {
+			return node, isAuth, nil
 		}
+	}
+	return p, false, nil
+}
 
-		// 如果请求URI不在授权列表中，返回403错误
-		c.JSON(http.StatusOK, result.Fail(403, "无权限操作"))
-		c.Abort()
-		return
+// load 返回缓存的节点表，过期后通过 NodeList 重新加载
+func (n *nodeRuleCache) load() (map[string]bool, error) {
+	n.mu.RLock()
+	nodes, expire := n.nodes, n.expire
+	n.mu.RUnlock()
+	if nodes != nil && time.Now().Before(expire) {
+		return nodes, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	response, err := ProjectServiceClient.NodeList(ctx, &project.ProjectRpcMessage{})
+	if err != nil {
+		return nil, err
 	}
+	nodes = make(map[string]bool)
+	var walk func(list []*project.ProjectNodeMessage)
+	walk = func(list []*project.ProjectNodeMessage) {
+		for _, v := range list {
+			nodes[v.Node] = v.IsAuth == 1
+			walk(v.Children)
+		}
+	}
+	walk(response.Nodes)
+	n.mu.Lock()
+	n.nodes, n.expire = nodes, time.Now().Add(nodeRulesTTL)
+	n.mu.Unlock()
+	return nodes, nil
+}
+
+// authGrants 成员在组织中拥有的权限节点的缓存
+var authGrants = &authGrantCache{entries: make(map[string]*authGrant)}
+
+type authGrant struct {
+	nodes   map[string]struct{}
+	isOwner bool
+	expire  time.Time
+}
+
+type authGrantCache struct {
+	mu      sync.RWMutex
+	entries map[string]*authGrant
+}
+
+// get 查询成员在组织中的权限节点，缓存未命中时调用 AuthNodesByMemberId
+func (a *authGrantCache) get(memberId int64, organizationCode string) (*authGrant, error) {
+	key := strconv.FormatInt(memberId, 10) + "::" + organizationCode
+	a.mu.RLock()
+	g := a.entries[key]
+	a.mu.RUnlock()
+	if g != nil && time.Now().Before(g.expire) {
+		return g, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	msg := &auth.AuthReqMessage{MemberId: memberId, OrganizationCode: organizationCode}
+	response, err := AuthServiceClient.AuthNodesByMemberId(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	g = &authGrant{
+		nodes:   make(map[string]struct{}, len(response.List)),
+		isOwner: response.IsOwner,
+		expire:  time.Now().Add(authGrantTTL),
+	}
+	for _, v := range response.List {
+		g.nodes[v] = struct{}{}
+	}
+	a.mu.Lock()
+	if len(a.entries) >= authGrantMaxEntries {
+		a.sweep()
+	}
+	a.entries[key] = g
+	a.mu.Unlock()
+	return g, nil
+}
+
+// sweep 清理过期的缓存，调用方需要持有写锁
+func (a *authGrantCache) sweep() {
+	now := time.Now()
+	for k, g := range a.entries {
+		if now.After(g.expire) {
+			delete(a.entries, k)
+		}
+	}
+}
+
+// InitAuthGrants 订阅项目服务的权限变化通知，任一网关实例修改角色的权限节点后，所有实例都丢弃接口权限校验的缓存
+func InitAuthGrants() {
+	pubsub := dao.Rc.Subscribe(context.Background(), authGrantChannel)
+	go func() {
+		for range pubsub.Channel() {
+			authGrants.flush()
+		}
+	}()
+}
+
+// flush 清空缓存，角色的权限节点修改后调用
+func (a *authGrantCache) flush() {
+	a.mu.Lock()
+	a.entries = make(map[string]*authGrant)
+	a.mu.Unlock()
 }
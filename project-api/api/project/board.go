@@ -0,0 +1,125 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"project-api/pkg/dao"
+	"project-api/pkg/model/tasks"
+	"sync"
+	"time"
+)
+
+const (
+	// boardEventChannel 项目服务发布看板变化事件的频道，与项目服务保持一致
+	boardEventChannel = "BOARD_EVENT"
+	// boardHeartbeat 没有事件时发送心跳的间隔，避免空闲的连接被代理断开
+	boardHeartbeat = 30 * time.Second
+	// boardBuffer 每个连接缓存的事件数，缓存写满说明客户端消费太慢，断开连接后由客户端重连
+	boardBuffer = 64
+)
+
+// boardMessage 推送给客户端的事件，data 为项目服务发布的原始内容
+type boardMessage struct {
+	event string
+	data  string
+}
+
+// boards 网关本地的看板订阅，按项目编号保存订阅了该项目的连接。
+// 每个网关实例都订阅 redis 频道，收到事件后推送给本实例上订阅了该项目的连接
+var boards = &boardHub{subs: make(map[string]map[chan *boardMessage]struct{})}
+
+type boardHub struct {
+	mu   sync.Mutex
+	subs map[string]map[chan *boardMessage]struct{}
+}
+
+// InitBoard 订阅项目服务发布的看板变化事件
+func InitBoard() {
+	pubsub := dao.Rc.Subscribe(context.Background(), boardEventChannel)
+	go func() {
+		for msg := range pubsub.Channel() {
+			var e tasks.BoardEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &e); err != nil || e.ProjectCode == "" {
+				zap.L().Error("board event payload error", zap.String("payload", msg.Payload))
+				continue
+			}
+			boards.publish(e.ProjectCode, &boardMessage{event: e.Type, data: msg.Payload})
+		}
+	}()
+}
+
+// subscribe 订阅项目的看板事件，返回事件通道和取消订阅的函数。
+// 客户端消费太慢时通道会被关闭
+func (h *boardHub) subscribe(projectCode string) (<-chan *boardMessage, func()) {
+	ch := make(chan *boardMessage, boardBuffer)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[projectCode] == nil {
+		h.subs[projectCode] = make(map[chan *boardMessage]struct{})
+	}
+	h.subs[projectCode][ch] = struct{}{}
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(projectCode, ch)
+	}
+}
+
+// publish 把事件推送给订阅了该项目的连接，不等待消费太慢的连接
+func (h *boardHub) publish(projectCode string, m *boardMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[projectCode] {
+		select {
+		case ch <- m:
+		default:
+			h.remove(projectCode, ch)
+		}
+	}
+}
+
+// remove 移除连接并关闭通道，调用方需要持有锁
+func (h *boardHub) remove(projectCode string, ch chan *boardMessage) {
+	subs := h.subs[projectCode]
+	if _, ok := subs[ch]; !ok {
+		return
+	}
+	delete(subs, ch)
+	close(ch)
+	if len(subs) == 0 {
+		delete(h.subs, projectCode)
+	}
+}
+
+// events 使用 Server-Sent Events 推送项目看板的变化事件，事件名为事件类型，数据为事件的 JSON。
+// 连接断开后 EventSource 会自动重连，客户端重连后应重新查询看板，期间的事件不会补发
+func (p *HandlerProjectV2) events(c *gin.Context) {
+	events, cancel := boards.subscribe(c.Param("projectCode"))
+	defer cancel()
+	heartbeat := time.NewTicker(boardHeartbeat)
+	defer heartbeat.Stop()
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case m, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(m.event, m.data)
+		case <-heartbeat.C:
+			// 注释行，EventSource 会忽略
+			io.WriteString(w, ": ping\n\n")
+		}
+		return true
+	})
+}
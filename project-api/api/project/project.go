@@ -29,7 +29,7 @@ func (p *HandlerProject) index(c *gin.Context) {
 
 	// 创建一个带有超时的上下文，以确保请求不会无限期地等待。
 	// 这里设置超时时间为2秒。
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	// 延迟取消上下文，以确保在函数退出时清理资源。
 	defer cancel()
 
@@ -58,7 +58,7 @@ func (p *HandlerProject) myProjectList(c *gin.Context) {
 	result := &common.Result{}
 
 	// 1. 获取参数
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 从上下文中获取memberId
@@ -116,7 +116,7 @@ func (p *HandlerProject) projectTemplate(c *gin.Context) {
 	result := &common.Result{}
 
 	// 创建上下文并设置超时时间，确保 RPC 调用不会无限期等待。
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 从 gin.Context 中提取用户相关的参数：memberId 和 memberName。
@@ -178,7 +178,7 @@ func (p *HandlerProject) projectSave(c *gin.Context) {
 	result := &common.Result{}
 
 	// 1. 获取参数
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	memberId := c.GetInt64("memberId")
 	organizationCode := c.GetString("organizationCode")
@@ -223,7 +223,7 @@ func (p *HandlerProject) readProject(c *gin.Context) {
 	memberId := c.GetInt64("memberId")
 
 	// 创建一个带有超时的上下文，以确保请求不会无限期地等待
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel() // 确保在函数退出时取消上下文
 
 	// 调用项目服务客户端的FindProjectDetail方法获取项目详细信息
@@ -255,7 +255,7 @@ func (p *HandlerProject) recycleProject(c *gin.Context) {
 	projectCode := c.PostForm("projectCode")
 
 	// 创建一个带有超时的上下文，以确保 gRPC 调用不会无限期地等待。
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel() // 确保在函数退出时取消上下文。
 
 	// 调用 gRPC 服务，将项目标记为删除。
@@ -277,7 +277,7 @@ func (p *HandlerProject) recycleProject(c *gin.Context) {
 func (p *HandlerProject) recoveryProject(c *gin.Context) {
 	result := &common.Result{}
 	projectCode := c.PostForm("projectCode")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	_, err := ProjectServiceClient.UpdateDeletedProject(ctx, &project.ProjectRpcMessage{ProjectCode: projectCode, Deleted: false})
 	if err != nil {
@@ -295,7 +295,7 @@ func (p *HandlerProject) collectProject(c *gin.Context) {
 	projectCode := c.PostForm("projectCode")
 	collectType := c.PostForm("type")
 	memberId := c.GetInt64("memberId")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	_, err := ProjectServiceClient.UpdateCollectProject(ctx, &project.ProjectRpcMessage{ProjectCode: projectCode, CollectType: collectType, MemberId: memberId})
 	if err != nil {
@@ -313,10 +313,11 @@ func (p *HandlerProject) editProject(c *gin.Context) {
 	var req *pro.ProjectReq
 	_ = c.ShouldBind(&req)
 	memberId := c.GetInt64("memberId")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	msg := &project.UpdateProjectMessage{}
 	copier.Copy(msg, req)
+	msg.ProjectCode = authorizedProject(c)
 	msg.MemberId = memberId
 	_, err := ProjectServiceClient.UpdateProject(ctx, msg)
 	if err != nil {
@@ -326,12 +327,37 @@ func (p *HandlerProject) editProject(c *gin.Context) {
 	c.JSON(http.StatusOK, result.Success([]int{}))
 }
 
+// updateMemberRole 修改项目成员的角色，只有项目的拥有者和管理员可以修改
+func (p *HandlerProject) updateMemberRole(c *gin.Context) {
+	result := &common.Result{}
+	var req pro.ProjectMemberRoleReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &project.ProjectMemberRoleMessage{
+		MemberId:    c.GetInt64("memberId"),
+		ProjectCode: authorizedProject(c),
+		MemberCode:  req.MemberCode,
+		Role:        req.Role,
+	}
+	_, err := ProjectServiceClient.UpdateProjectMemberRole(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success([]int{}))
+}
+
 // getLogBySelfProject 是一个处理获取当前用户创建的项目日志的函数。
 func (p *HandlerProject) getLogBySelfProject(c *gin.Context) {
 	result := &common.Result{}
 	var page = &model.Page{}
 	page.Bind(c)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	// 创建一个 gRPC 消息，用于传递分页信息。
 	msg := &project.ProjectRpcMessage{
@@ -356,7 +382,7 @@ func (p *HandlerProject) getLogBySelfProject(c *gin.Context) {
 
 func (p *HandlerProject) nodeList(c *gin.Context) {
 	result := &common.Result{}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	response, err := ProjectServiceClient.NodeList(ctx, &project.ProjectRpcMessage{})
 	if err != nil {
@@ -370,23 +396,25 @@ func (p *HandlerProject) nodeList(c *gin.Context) {
 	}))
 }
 
-func (p *HandlerProject) FindProjectByMemberId(memberId int64, projectCode string, taskCode string) (*pro.Project, bool, bool, *errs.BError) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// FindProjectByMemberId 按项目、任务或任务阶段查询成员所在的项目，返回项目、是否为项目成员以及成员在项目中的角色
+func (p *HandlerProject) FindProjectByMemberId(ctx context.Context, memberId int64, scope projectScope) (*pro.Project, bool, string, *errs.BError) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	msg := &project.ProjectRpcMessage{
 		MemberId:    memberId,
-		ProjectCode: projectCode,
-		TaskCode:    taskCode,
+		ProjectCode: scope.projectCode,
+		TaskCode:    scope.taskCode,
+		StageCode:   scope.stageCode,
 	}
 	projectResponse, err := ProjectServiceClient.FindProjectByMemberId(ctx, msg)
 	if err != nil {
 		code, msg := errs.ParseGrpcError(err)
-		return nil, false, false, errs.NewError(errs.ErrorCode(code), msg)
+		return nil, false, "", errs.NewError(errs.ErrorCode(code), msg)
 	}
 	if projectResponse.Project == nil {
-		return nil, false, false, nil
+		return nil, false, "", nil
 	}
 	pr := &pro.Project{}
 	copier.Copy(pr, projectResponse.Project)
-	return pr, true, projectResponse.IsOwner, nil
+	return pr, true, projectResponse.Role, nil
 }
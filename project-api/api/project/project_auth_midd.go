@@ -3,52 +3,144 @@ package project
 import (
 	"github.com/gin-gonic/gin"
 	"net/http"
-	common "project-common"
-	"project-common/errs"
+	"project-api/api/midd"
 )
 
+// 项目角色，与项目服务保持一致
+const (
+	projectRoleOwner  = "owner"
+	projectRoleAdmin  = "admin"
+	projectRoleMember = "member"
+	projectRoleViewer = "viewer"
+)
+
+// projectRoleRank 项目角色的权限等级，等级高的角色拥有等级低的角色的全部权限
+var projectRoleRank = map[string]int{
+	projectRoleViewer: 1,
+	projectRoleMember: 2,
+	projectRoleAdmin:  3,
+	projectRoleOwner:  4,
+}
+
+// projectRoute 接口要求的最低项目角色，以及处理函数用来确定项目、任务和任务阶段的参数名。
+// v1 接口从表单中读取这些参数；REST 接口从路径参数 projectCode、taskCode、stageCode 中读取，字段名为空的参数不读取。
+// 网关只按处理函数实际使用的参数校验权限，请求中多余的项目参数不参与校验。
+type projectRoute struct {
+	role    string
+	project string
+	task    string
+	stage   string
+}
+
+// projectRoutes 操作项目或任务的接口，只读访客只能调用查询类接口。
+// 这些接口的参数必须齐全，否则返回403；不在其中的接口带有 projectCode 或 taskCode 时按 defaultProjectRoute 校验
+var projectRoutes = map[string]projectRoute{
+	"/project/project/read":            {role: projectRoleViewer, project: "projectCode"},
+	"/project/project_collect/collect": {role: projectRoleViewer, project: "projectCode"},
+	"/project/task_stages":             {role: projectRoleViewer, project: "projectCode"},
+	"/project/task_stages/tasks":       {role: projectRoleViewer, stage: "stageCode"},
+	"/project/project_member/index":    {role: projectRoleViewer, project: "projectCode"},
+	"/project/task/read":               {role: projectRoleViewer, task: "taskCode"},
+	"/project/task_member":             {role: projectRoleViewer, task: "taskCode"},
+	"/project/task/taskLog":            {role: projectRoleViewer, task: "taskCode"},
+	"/project/task/_taskWorkTimeList":  {role: projectRoleViewer, task: "taskCode"},
+	"/project/task/taskSources":        {role: projectRoleViewer, task: "taskCode"},
+	"/project/task/save":               {role: projectRoleMember, project: "project_code", stage: "stage_code"},
+	"/project/task/edit":               {role: projectRoleMember, task: "taskCode"},
+	"/project/task/sort":               {role: projectRoleMember, task: "preTaskCode"},
+	"/project/task/saveTaskWorkTime":   {role: projectRoleMember, task: "taskCode"},
+	"/project/task/createComment":      {role: projectRoleMember, task: "taskCode"},
+	"/project/file/uploadFiles":        {role: projectRoleMember, project: "projectCode", task: "taskCode"},
+	"/project/project/edit":            {role: projectRoleAdmin, project: "projectCode"},
+	"/project/project_member/role":     {role: projectRoleAdmin, project: "projectCode"},
+	"/project/project/recycle":         {role: projectRoleOwner, project: "projectCode"},
+	"/project/project/recovery":        {role: projectRoleOwner, project: "projectCode"},
+}
+
+// defaultProjectRoute 不在 projectRoutes 中的接口，带有项目或任务参数时要求是项目成员
+var defaultProjectRoute = projectRoute{role: projectRoleMember, project: "projectCode", task: "taskCode"}
+
+// projectScope 请求中用于确定所属项目的参数，项目服务解析出现的每个参数，它们必须属于同一个项目
+type projectScope struct {
+	projectCode string
+	taskCode    string
+	stageCode   string
+}
+
+func (s projectScope) empty() bool {
+	return s.projectCode == "" && s.taskCode == "" && s.stageCode == ""
+}
+
+// complete 接口声明的参数是否都有值
+func (s projectScope) complete(route projectRoute) bool {
+	return (route.project == "" || s.projectCode != "") &&
+		(route.task == "" || s.taskCode != "") &&
+		(route.stage == "" || s.stageCode != "")
+}
+
+// projectCodeKey 校验通过的项目编码在 gin 上下文中的 key
+const projectCodeKey = "authProjectCode"
+
 // ProjectAuth 项目权限
+// 在接口权限的基础上校验项目权限：根据接口使用的项目、任务或任务阶段参数确定所属的项目，当前用户必须是该项目的成员，
+// 且成员在项目中的角色不低于接口要求的最低角色，否则返回403。参数属于不同的项目时同样返回403。
+// projectRoutes 中的接口参数不全时也返回403。校验通过后项目角色保存在 projectRole 中，项目编码通过 authorizedProject 获取。
 func ProjectAuth() func(*gin.Context) {
 	return func(c *gin.Context) {
-		//如果此用户 不是项目的成员 认为你不能查看 不能操作此项目 直接报无权限
-		result := &common.Result{}
-		//在接口有权限的基础上，做项目权限，不是这个项目的成员，无权限查看项目和操作项目
-		//检查是否有projectCode和taskCode这两个参数
-		isProjectAuth := false
-		projectCode := c.PostForm("projectCode")
-		if projectCode != "" {
-			isProjectAuth = true
-		}
-		taskCode := c.PostForm("taskCode")
-		if taskCode != "" {
-			isProjectAuth = true
-		}
-		if isProjectAuth {
-			memberId := c.GetInt64("memberId")
-			p := New()
-			pr, isMember, isOwner, err := p.FindProjectByMemberId(memberId, projectCode, taskCode)
-			if err != nil {
-				code, msg := errs.ParseGrpcError(err)
-				c.JSON(http.StatusOK, result.Fail(code, msg))
-				c.Abort()
-				return
-			}
-			if !isMember {
-				c.JSON(http.StatusOK, result.Fail(403, "不是项目成员，无操作权限"))
-				c.Abort()
-				return
-			}
-			if pr.Private == 1 {
-				//私有项目
-				if isOwner || isMember {
-					c.Next()
-					return
-				} else {
-					c.JSON(http.StatusOK, result.Fail(403, "私有项目，无操作权限"))
-					c.Abort()
-					return
-				}
-			}
+		route, scoped := projectRoutes[routePath(c)]
+		if !scoped {
+			route = defaultProjectRoute
+		}
+		scope := projectParams(c, route)
+		if scoped && !scope.complete(route) {
+			midd.Abort(c, http.StatusForbidden, "缺少项目参数，无操作权限")
+			return
+		}
+		if scope.empty() {
+			c.Next()
+			return
+		}
+		p := New()
+		pr, isMember, role, err := p.FindProjectByMemberId(c.Request.Context(), c.GetInt64("memberId"), scope)
+		if err != nil {
+			midd.AbortError(c, err)
+			return
+		}
+		if !isMember {
+			midd.Abort(c, http.StatusForbidden, "不是项目成员，无操作权限")
+			return
+		}
+		if projectRoleRank[role] < projectRoleRank[route.role] {
+			midd.Abort(c, http.StatusForbidden, "项目角色权限不足，无操作权限")
+			return
+		}
+		c.Set("projectRole", role)
+		c.Set(projectCodeKey, pr.Code)
+		c.Next()
+	}
+}
+
+// authorizedProject 返回 ProjectAuth 校验通过的项目编码，处理函数把它传给项目服务，由项目服务校验任务是否属于该项目
+func authorizedProject(c *gin.Context) string {
+	return c.GetString(projectCodeKey)
+}
+
+// projectParams 读取接口声明的项目、任务和任务阶段参数。
+// REST 接口读取路径参数，v1 接口读取表单参数，与处理函数读取参数的方式保持一致
+func projectParams(c *gin.Context, route projectRoute) projectScope {
+	rest := c.GetString(v1PathKey) != ""
+	read := func(name string, pathName string) string {
+		if name == "" {
+			return ""
+		}
+		if rest {
+			return c.Param(pathName)
 		}
+		return c.PostForm(name)
+	}
+	return projectScope{
+		projectCode: read(route.project, "projectCode"),
+		taskCode:    read(route.task, "taskCode"),
+		stageCode:   read(route.stage, "stageCode"),
 	}
 }
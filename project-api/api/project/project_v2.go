@@ -0,0 +1,318 @@
+package project
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/copier"
+	"net/http"
+	"project-api/api/midd"
+	"project-api/pkg/model"
+	"project-api/pkg/model/pro"
+	"project-api/pkg/model/tasks"
+	"project-grpc/project"
+	"project-grpc/task"
+	"time"
+)
+
+// HandlerProjectV2 项目资源的 REST 接口（/api/v2/projects），请求体为 JSON，
+// 成功时直接返回资源，失败时返回业务码对应的 HTTP 状态码和 {code, msg}
+type HandlerProjectV2 struct {
+}
+
+func NewProjectV2() *HandlerProjectV2 {
+	return &HandlerProjectV2{}
+}
+
+// list 查询项目列表，selectBy 为 my、archive、deleted 或 collect
+func (p *HandlerProjectV2) list(c *gin.Context) {
+	page := &model.Page{}
+	page.Bind(c)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &project.ProjectRpcMessage{
+		MemberId:   c.GetInt64("memberId"),
+		MemberName: c.GetString("memberName"),
+		SelectBy:   c.Query("selectBy"),
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+	}
+	rsp, err := ProjectServiceClient.FindProjectByMemId(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*pro.ProjectAndMember{}
+	copier.Copy(&list, rsp.Pm)
+	c.JSON(http.StatusOK, gin.H{
+		"list":  list,
+		"total": rsp.Total,
+	})
+}
+
+// create 创建项目，返回 201 和新项目
+func (p *HandlerProjectV2) create(c *gin.Context) {
+	var req pro.SaveProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Name == "" {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &project.ProjectRpcMessage{
+		MemberId:         c.GetInt64("memberId"),
+		OrganizationCode: c.GetString("organizationCode"),
+		TemplateCode:     req.TemplateCode,
+		Name:             req.Name,
+		Description:      req.Description,
+	}
+	saveProject, err := ProjectServiceClient.SaveProject(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	rsp := &pro.SaveProject{}
+	copier.Copy(rsp, saveProject)
+	c.Header("Location", "/api/v2/projects/"+rsp.Code)
+	c.JSON(http.StatusCreated, rsp)
+}
+
+// read 查询项目详情
+func (p *HandlerProjectV2) read(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	pd, err := p.detail(ctx, c.Param("projectCode"), c.GetInt64("memberId"))
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, pd)
+}
+
+// update 修改项目，只修改请求中出现的字段，返回修改后的项目详情
+func (p *HandlerProjectV2) update(c *gin.Context) {
+	var req pro.ProjectPatchReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	projectCode := c.Param("projectCode")
+	memberId := c.GetInt64("memberId")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	// 项目服务按请求覆盖所有字段，先查询当前的项目再合并请求中的字段
+	pd, err := p.detail(ctx, projectCode, memberId)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	msg := &project.UpdateProjectMessage{
+		ProjectCode:        projectCode,
+		MemberId:           memberId,
+		Cover:              patch(req.Cover, pd.Cover),
+		Name:               patch(req.Name, pd.Name),
+		Description:        patch(req.Description, pd.Description),
+		Schedule:           patch(req.Schedule, pd.Schedule),
+		Private:            int32(patch(req.Private, pd.Private)),
+		Prefix:             patch(req.Prefix, pd.Prefix),
+		OpenPrefix:         int32(patch(req.OpenPrefix, pd.OpenPrefix)),
+		OpenBeginTime:      int32(patch(req.OpenBeginTime, pd.OpenBeginTime)),
+		OpenTaskPrivate:    int32(patch(req.OpenTaskPrivate, pd.OpenTaskPrivate)),
+		TaskBoardTheme:     patch(req.TaskBoardTheme, pd.TaskBoardTheme),
+		AutoUpdateSchedule: int32(patch(req.AutoUpdateSchedule, pd.AutoUpdateSchedule)),
+	}
+	if _, err := ProjectServiceClient.UpdateProject(ctx, msg); err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	pd, err = p.detail(ctx, projectCode, memberId)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, pd)
+}
+
+// remove 将项目移入回收站，返回 204
+func (p *HandlerProjectV2) remove(c *gin.Context) {
+	p.setDeleted(c, true)
+}
+
+// recover 从回收站恢复项目，返回 204
+func (p *HandlerProjectV2) recover(c *gin.Context) {
+	p.setDeleted(c, false)
+}
+
+// setDeleted 修改项目的删除状态
+func (p *HandlerProjectV2) setDeleted(c *gin.Context, deleted bool) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &project.ProjectRpcMessage{ProjectCode: c.Param("projectCode"), Deleted: deleted}
+	if _, err := ProjectServiceClient.UpdateDeletedProject(ctx, msg); err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// collect 收藏项目，返回 204
+func (p *HandlerProjectV2) collect(c *gin.Context) {
+	p.setCollected(c, "collect")
+}
+
+// uncollect 取消收藏项目，返回 204
+func (p *HandlerProjectV2) uncollect(c *gin.Context) {
+	p.setCollected(c, "cancel")
+}
+
+// setCollected 修改项目的收藏状态
+func (p *HandlerProjectV2) setCollected(c *gin.Context, collectType string) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &project.ProjectRpcMessage{
+		ProjectCode: c.Param("projectCode"),
+		CollectType: collectType,
+		MemberId:    c.GetInt64("memberId"),
+	}
+	if _, err := ProjectServiceClient.UpdateCollectProject(ctx, msg); err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// members 查询项目成员列表
+func (p *HandlerProjectV2) members(c *gin.Context) {
+	page := &model.Page{}
+	page.Bind(c)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		MemberId:    c.GetInt64("memberId"),
+		ProjectCode: c.Param("projectCode"),
+		Page:        page.Page,
+		PageSize:    page.PageSize,
+	}
+	rsp, err := TaskServiceClient.MemberProjectList(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*pro.MemberProjectResp{}
+	copier.Copy(&list, rsp.List)
+	c.JSON(http.StatusOK, gin.H{
+		"list":  list,
+		"total": rsp.Total,
+	})
+}
+
+// updateMemberRole 修改项目成员的角色，返回 204
+func (p *HandlerProjectV2) updateMemberRole(c *gin.Context) {
+	var req pro.ProjectMemberPatchReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.Role == "" {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &project.ProjectMemberRoleMessage{
+		MemberId:    c.GetInt64("memberId"),
+		ProjectCode: c.Param("projectCode"),
+		MemberCode:  c.Param("memberCode"),
+		Role:        req.Role,
+	}
+	if _, err := ProjectServiceClient.UpdateProjectMemberRole(ctx, msg); err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// stages 查询项目的任务阶段列表
+func (p *HandlerProjectV2) stages(c *gin.Context) {
+	page := &model.Page{}
+	page.Bind(c)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		MemberId:    c.GetInt64("memberId"),
+		ProjectCode: c.Param("projectCode"),
+		Page:        page.Page,
+		PageSize:    page.PageSize,
+	}
+	rsp, err := TaskServiceClient.TaskStages(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*tasks.TaskStagesResp{}
+	copier.Copy(&list, rsp.List)
+	c.JSON(http.StatusOK, gin.H{
+		"list":  list,
+		"total": rsp.Total,
+	})
+}
+
+// stageTasks 查询任务阶段下的任务列表
+func (p *HandlerProjectV2) stageTasks(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{StageCode: c.Param("stageCode"), ProjectCode: authorizedProject(c), MemberId: c.GetInt64("memberId")}
+	rsp, err := TaskServiceClient.TaskList(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*tasks.TaskDisplay{}
+	copier.Copy(&list, rsp.List)
+	for _, v := range list {
+		fillTaskDisplay(v)
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// createTask 在任务阶段下创建任务，返回 201 和新任务
+func (p *HandlerProjectV2) createTask(c *gin.Context) {
+	var req tasks.TaskCreateReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.Name == "" {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		ProjectCode: authorizedProject(c),
+		StageCode:   c.Param("stageCode"),
+		Name:        req.Name,
+		AssignTo:    req.AssignTo,
+		MemberId:    c.GetInt64("memberId"),
+	}
+	taskMessage, err := TaskServiceClient.SaveTask(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	td := &tasks.TaskDisplay{}
+	copier.Copy(td, taskMessage)
+	fillTaskDisplay(td)
+	c.Header("Location", "/api/v2/tasks/"+td.Code)
+	c.JSON(http.StatusCreated, td)
+}
+
+// detail 查询项目详情
+func (p *HandlerProjectV2) detail(ctx context.Context, projectCode string, memberId int64) (*pro.ProjectDetail, error) {
+	detail, err := ProjectServiceClient.FindProjectDetail(ctx, &project.ProjectRpcMessage{ProjectCode: projectCode, MemberId: memberId})
+	if err != nil {
+		return nil, err
+	}
+	pd := &pro.ProjectDetail{}
+	copier.Copy(pd, detail)
+	return pd, nil
+}
+
+// patch 返回请求中的字段，字段没有出现在请求中时返回当前值
+func patch[T any](v *T, current T) T {
+	if v == nil {
+		return current
+	}
+	return *v
+}
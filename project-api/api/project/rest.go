@@ -0,0 +1,30 @@
+package project
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// v1PathKey REST 接口对应的 v1 接口路径
+const v1PathKey = "v1Path"
+
+// restRoute 返回 REST 接口（/api/v2）的处理链。
+// REST 接口没有单独的权限节点，接口权限和项目权限按对应的 v1 接口校验，角色拥有 v1 接口的权限即可调用对应的 REST 接口
+func restRoute(v1Path string, h gin.HandlerFunc) []gin.HandlerFunc {
+	return []gin.HandlerFunc{
+		func(c *gin.Context) {
+			c.Set(v1PathKey, v1Path)
+			c.Next()
+		},
+		Auth(),
+		ProjectAuth(),
+		h,
+	}
+}
+
+// routePath 返回校验权限使用的接口路径，REST 接口返回对应的 v1 接口路径
+func routePath(c *gin.Context) string {
+	if p := c.GetString(v1PathKey); p != "" {
+		return p
+	}
+	return c.FullPath()
+}
@@ -19,13 +19,23 @@ func init() {
 func (*RouterProject) Route(r *gin.Engine) {
 	//初始化grpc的客户端连接
 	InitRpcProjectClient()
+	// 订阅看板变化事件
+	InitBoard()
+	// 订阅角色权限变化通知
+	InitAuthGrants()
 	h := New()
 	// 定义对应的路由组规则
 	group := r.Group("/project")
 	// 使用TokenVerify中间件对项目列表的API进行身份验证
 	group.Use(midd.TokenVerify())
-	//group.Use(Auth())
-	//group.Use(ProjectAuth())
+	// 灰度用户的请求转发到服务的灰度版本
+	group.Use(midd.Canary())
+	// 按登录用户限流
+	group.Use(midd.RateLimit())
+	// 写接口支持 Idempotency-Key 请求头
+	group.Use(midd.Idempotency())
+	group.Use(Auth())
+	group.Use(ProjectAuth())
 	group.POST("/index", h.index)                                     // Index 获取项目的菜单列表
 	group.POST("/project/selfList", h.myProjectList)                  // myProjectList 获取用户自身项目列表请求
 	group.POST("/project", h.myProjectList)                           // myProjectList 获取项目列表请求
@@ -41,6 +51,7 @@ func (*RouterProject) Route(r *gin.Engine) {
 	t := NewTask()
 	group.POST("/task_stages", t.taskStages)                 // taskStages 查找对应的任务阶段列表。
 	group.POST("/project_member/index", t.memberProjectList) // memberProjectList 查询项目成员列表。
+	group.POST("/project_member/role", h.updateMemberRole)   // updateMemberRole 修改项目成员的角色。
 	group.POST("/task_stages/tasks", t.taskList)             // taskList 查找对应的任务列表。
 	group.POST("/task/save", t.saveTask)                     // saveTask 保存任务。
 	group.POST("/task/edit", t.editTask)                     // editTask 修改任务。
@@ -67,4 +78,45 @@ func (*RouterProject) Route(r *gin.Engine) {
 	group.POST("/auth/apply", auth.apply)
 	menu := NewMenu()
 	group.POST("/menu/menu", menu.menuList)
+
+	// REST 风格的接口，请求体为 JSON，失败时返回对应的 HTTP 状态码，权限按对应的 v1 接口校验
+	v2 := r.Group("/api/v2")
+	v2.Use(midd.RestApi())
+	v2.Use(midd.TokenVerify())
+	v2.Use(midd.Canary())
+	v2.Use(midd.RateLimit())
+	v2.Use(midd.Idempotency())
+	pv := NewProjectV2()
+	v2.GET("/projects", restRoute("/project/project", pv.list)...)
+	v2.POST("/projects", restRoute("/project/project/save", pv.create)...)
+	v2.GET("/projects/:projectCode", restRoute("/project/project/read", pv.read)...)
+	v2.PATCH("/projects/:projectCode", restRoute("/project/project/edit", pv.update)...)
+	v2.DELETE("/projects/:projectCode", restRoute("/project/project/recycle", pv.remove)...)
+	v2.POST("/projects/:projectCode/recovery", restRoute("/project/project/recovery", pv.recover)...)
+	v2.PUT("/projects/:projectCode/collect", restRoute("/project/project_collect/collect", pv.collect)...)
+	v2.DELETE("/projects/:projectCode/collect", restRoute("/project/project_collect/collect", pv.uncollect)...)
+	v2.GET("/projects/:projectCode/members", restRoute("/project/project_member/index", pv.members)...)
+	v2.PATCH("/projects/:projectCode/members/:memberCode", restRoute("/project/project_member/role", pv.updateMemberRole)...)
+	v2.GET("/projects/:projectCode/stages", restRoute("/project/task_stages", pv.stages)...)
+	v2.GET("/projects/:projectCode/stages/:stageCode/tasks", restRoute("/project/task_stages/tasks", pv.stageTasks)...)
+	v2.POST("/projects/:projectCode/stages/:stageCode/tasks", restRoute("/project/task/save", pv.createTask)...)
+	tv := NewTaskV2()
+	v2.GET("/tasks", restRoute("/project/task/selfList", tv.list)...)
+	v2.GET("/tasks/:taskCode", restRoute("/project/task/read", tv.read)...)
+	v2.PATCH("/tasks/:taskCode", restRoute("/project/task/edit", tv.update)...)
+	v2.POST("/tasks/:taskCode/move", restRoute("/project/task/sort", tv.move)...)
+	v2.GET("/tasks/:taskCode/members", restRoute("/project/task_member", tv.members)...)
+	v2.GET("/tasks/:taskCode/logs", restRoute("/project/task/taskLog", tv.logs)...)
+	v2.POST("/tasks/:taskCode/comments", restRoute("/project/task/createComment", tv.createComment)...)
+	v2.GET("/tasks/:taskCode/workTimes", restRoute("/project/task/_taskWorkTimeList", tv.workTimes)...)
+	v2.POST("/tasks/:taskCode/workTimes", restRoute("/project/task/saveTaskWorkTime", tv.createWorkTime)...)
+	v2.GET("/tasks/:taskCode/files", restRoute("/project/task/taskSources", tv.files)...)
+
+	// 看板事件使用 Server-Sent Events，浏览器的 EventSource 无法设置请求头，允许在查询参数中携带 Token
+	events := r.Group("/api/v2")
+	events.Use(midd.RestApi())
+	events.Use(midd.QueryToken())
+	events.Use(midd.TokenVerify())
+	events.Use(midd.RateLimit())
+	events.GET("/projects/:projectCode/events", restRoute("/project/task_stages", pv.events)...)
 }
@@ -8,6 +8,7 @@ import (
 	"project-api/config"
 	"project-common/discovery"
 	"project-common/logs"
+	"project-common/tracing"
 	"project-grpc/account"
 	"project-grpc/auth"
 	"project-grpc/department"
@@ -25,6 +26,22 @@ var DepartmentServiceClient department.DepartmentServiceClient
 var AuthServiceClient auth.AuthServiceClient
 var MenuServiceClient menu.MenuServiceClient
 
+// projectRetryMethods 网关调用的项目服务只读方法，服务不可用时可以安全重试
+var projectRetryMethods = discovery.RetryMethods{
+	"project.service.v1.ProjectService": {
+		"Index", "FindProjectByMemId", "FindProjectTemplate", "FindProjectDetail",
+		"GetLogBySelfProject", "NodeList", "FindProjectByMemberId",
+	},
+	"task.service.v1.TaskService": {
+		"TaskStages", "MemberProjectList", "TaskList", "MyTaskList", "ReadTask",
+		"ListTaskMember", "TaskLog", "TaskWorkTimeList", "TaskSources",
+	},
+	"account.service.v1.AccountService":       {"Account"},
+	"department.service.v1.DepartmentService": {"List", "Read"},
+	"auth.service.v1.AuthService":             {"AuthList", "AuthNodesByMemberId"},
+	"menu.service.v1.MenuService":             {"MenuList"},
+}
+
 // InitRpcProjectClient 初始化项目服务的 gRPC 客户端。
 // 该函数通过以下步骤完成初始化：
 // 1. 创建一个基于 etcd 的自定义解析器，用于动态解析服务地址。
@@ -37,9 +54,9 @@ func InitRpcProjectClient() {
 	// 将自定义解析器注册到 gRPC 系统中。
 	resolver.Register(etcdRegister)
 
-	// 使用 etcd 解析器建立 gRPC 连接，目标服务名为 "project"。
+	// 使用 etcd 解析器建立 gRPC 连接，目标服务名为 "project"，按服务权重负载均衡。
 	// 连接使用不安全的传输凭据（仅适用于开发环境，生产环境中应使用安全凭据）。
-	conn, err := grpc.Dial("127.0.0.1:8882", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := discovery.Dial("project", projectRetryMethods, config.C.Canary.Services["project"], append(tracing.DialOptions(), grpc.WithTransportCredentials(insecure.NewCredentials()))...)
 	if err != nil {
 		// 如果连接失败，记录致命错误并终止程序。
 		log.Fatalf("无法连接到服务: %v", err)
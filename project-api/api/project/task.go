@@ -33,7 +33,7 @@ func (t *HandlerTask) taskStages(c *gin.Context) {
 	result := &common.Result{}
 
 	// 创建一个带有超时的context
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 1. 获取参数并校验参数的合法性
@@ -86,7 +86,7 @@ func (t *HandlerTask) memberProjectList(c *gin.Context) {
 	result := &common.Result{}
 
 	// 创建一个带有超时的上下文，以确保请求不会无限期地等待
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 1. 获取参数并校验参数的合法性
@@ -136,11 +136,11 @@ func (t *HandlerTask) taskList(c *gin.Context) {
 	stageCode := c.PostForm("stageCode")
 
 	// 创建一个带有超时的上下文，以确保请求不会无限期地等待。
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 调用TaskServiceClient的服务获取任务列表。
-	list, err := TaskServiceClient.TaskList(ctx, &task.TaskReqMessage{StageCode: stageCode, MemberId: c.GetInt64("memberId")})
+	list, err := TaskServiceClient.TaskList(ctx, &task.TaskReqMessage{StageCode: stageCode, ProjectCode: authorizedProject(c), MemberId: c.GetInt64("memberId")})
 	if err != nil {
 		// 如果发生错误，解析gRPC错误并返回错误信息。
 		code, msg := errs.ParseGrpcError(err)
@@ -184,12 +184,12 @@ func (t *HandlerTask) saveTask(c *gin.Context) {
 	c.ShouldBind(&req)
 
 	// 创建一个带有2秒超时的上下文，用于控制gRPC调用的最长执行时间。
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 构建任务保存请求消息。
 	msg := &task.TaskReqMessage{
-		ProjectCode: req.ProjectCode,
+		ProjectCode: authorizedProject(c),
 		Name:        req.Name,
 		StageCode:   req.StageCode,
 		AssignTo:    req.AssignTo,
@@ -234,15 +234,16 @@ func (t *HandlerTask) editTask(c *gin.Context) {
 
 	fmt.Println(req)
 	// 创建一个带有2秒超时的上下文，用于控制gRPC调用的最长执行时间。
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 构建任务保存请求消息。
 	msg := &task.TaskReqMessage{
-		Name:     req.Name,
-		TaskCode: req.TaskCode,
-		AssignTo: req.AssignTo,
-		MemberId: c.GetInt64("memberId"),
+		Name:        req.Name,
+		TaskCode:    req.TaskCode,
+		ProjectCode: authorizedProject(c),
+		AssignTo:    req.AssignTo,
+		MemberId:    c.GetInt64("memberId"),
 	}
 
 	// 调用gRPC服务保存任务，并处理错误。
@@ -264,12 +265,14 @@ func (t *HandlerTask) taskSort(c *gin.Context) {
 	result := &common.Result{}
 	var req *tasks.TaskSortReq
 	c.ShouldBind(&req)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	msg := &task.TaskReqMessage{
 		PreTaskCode:  req.PreTaskCode,
 		NextTaskCode: req.NextTaskCode,
 		ToStageCode:  req.ToStageCode,
+		ProjectCode:  authorizedProject(c),
+		MemberId:     c.GetInt64("memberId"),
 	}
 	_, err := TaskServiceClient.TaskSort(ctx, msg)
 	if err != nil {
@@ -287,7 +290,7 @@ func (t *HandlerTask) myTaskList(c *gin.Context) {
 	var req *tasks.MyTaskReq
 	c.ShouldBind(&req)
 	memberId := c.GetInt64("memberId")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	msg := &task.TaskReqMessage{
 		MemberId: memberId,
@@ -322,11 +325,12 @@ func (t *HandlerTask) myTaskList(c *gin.Context) {
 func (t *HandlerTask) readTask(c *gin.Context) {
 	result := &common.Result{}
 	taskCode := c.PostForm("taskCode")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	msg := &task.TaskReqMessage{
-		TaskCode: taskCode,
-		MemberId: c.GetInt64("memberId"),
+		TaskCode:    taskCode,
+		ProjectCode: authorizedProject(c),
+		MemberId:    c.GetInt64("memberId"),
 	}
 	// 调用 TaskServiceClient 的 ReadTask 方法获取任务详情。
 	taskMessage, err := TaskServiceClient.ReadTask(ctx, msg)
@@ -354,13 +358,14 @@ func (t *HandlerTask) listTaskMember(c *gin.Context) {
 	taskCode := c.PostForm("taskCode")
 	page := &model.Page{}
 	page.Bind(c)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	msg := &task.TaskReqMessage{
-		TaskCode: taskCode,
-		MemberId: c.GetInt64("memberId"),
-		Page:     page.Page,
-		PageSize: page.PageSize,
+		TaskCode:    taskCode,
+		ProjectCode: authorizedProject(c),
+		MemberId:    c.GetInt64("memberId"),
+		Page:        page.Page,
+		PageSize:    page.PageSize,
 	}
 	// 调用 TaskServiceClient 的 ListTaskMember 方法获取任务成员列表。
 	taskMemberResponse, err := TaskServiceClient.ListTaskMember(ctx, msg)
@@ -392,16 +397,17 @@ func (t *HandlerTask) taskLog(c *gin.Context) {
 	if req.PageSize <= 0 {
 		req.PageSize = 10
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	// 创建一个任务日志请求消息对象，并设置相关参数。
 	msg := &task.TaskReqMessage{
-		TaskCode: req.TaskCode,
-		MemberId: c.GetInt64("memberId"),
-		Page:     int64(req.Page),
-		PageSize: int64(req.PageSize),
-		All:      int32(req.All),
-		Comment:  int32(req.Comment),
+		TaskCode:    req.TaskCode,
+		ProjectCode: authorizedProject(c),
+		MemberId:    c.GetInt64("memberId"),
+		Page:        int64(req.Page),
+		PageSize:    int64(req.PageSize),
+		All:         int32(req.All),
+		Comment:     int32(req.Comment),
 	}
 	// 调用 TaskServiceClient 的 TaskLog 方法获取任务日志列表。
 	taskLogResponse, err := TaskServiceClient.TaskLog(ctx, msg)
@@ -425,11 +431,12 @@ func (t *HandlerTask) taskLog(c *gin.Context) {
 func (t *HandlerTask) taskWorkTimeList(c *gin.Context) {
 	taskCode := c.PostForm("taskCode")
 	result := &common.Result{}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	msg := &task.TaskReqMessage{
-		TaskCode: taskCode,
-		MemberId: c.GetInt64("memberId"),
+		TaskCode:    taskCode,
+		ProjectCode: authorizedProject(c),
+		MemberId:    c.GetInt64("memberId"),
 	}
 	// 调用 TaskServiceClient 的 TaskWorkTimeList 方法获取任务工时列表。
 	taskWorkTimeResponse, err := TaskServiceClient.TaskWorkTimeList(ctx, msg)
@@ -451,15 +458,16 @@ func (t *HandlerTask) saveTaskWorkTime(c *gin.Context) {
 	result := &common.Result{}
 	var req *model.SaveTaskWorkTimeReq
 	c.ShouldBind(&req)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	// 创建一个任务工时请求消息对象，并设置相关参数。
 	msg := &task.TaskReqMessage{
-		TaskCode:  req.TaskCode,
-		MemberId:  c.GetInt64("memberId"),
-		Content:   req.Content,
-		Num:       int32(req.Num),
-		BeginTime: tms.ParseTime(req.BeginTime),
+		TaskCode:    req.TaskCode,
+		ProjectCode: authorizedProject(c),
+		MemberId:    c.GetInt64("memberId"),
+		Content:     req.Content,
+		Num:         int32(req.Num),
+		BeginTime:   tms.ParseTime(req.BeginTime),
 	}
 	// 调用 TaskServiceClient 的 SaveTaskWorkTime 方法保存任务工时。
 	_, err := TaskServiceClient.SaveTaskWorkTime(ctx, msg)
@@ -608,13 +616,13 @@ func (t *HandlerTask) uploadFiles(c *gin.Context) {
 		}
 	}
 	//调用服务 存入file表
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	// 创建一个任务文件请求消息对象，并设置相关参数。
 	fileUrl := "http://localhost:9009/" + key
 	msg := &task.TaskFileReqMessage{
 		TaskCode:         req.TaskCode,
-		ProjectCode:      req.ProjectCode,
+		ProjectCode:      authorizedProject(c),
 		OrganizationCode: c.GetString("organizationCode"),
 		PathName:         key,
 		FileName:         req.Filename,
@@ -647,10 +655,10 @@ func (t *HandlerTask) uploadFiles(c *gin.Context) {
 func (t *HandlerTask) taskSources(c *gin.Context) {
 	result := &common.Result{}
 	taskCode := c.PostForm("taskCode")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	// 调用 TaskServiceClient 的 TaskSources 方法获取任务来源。
-	sources, err := TaskServiceClient.TaskSources(ctx, &task.TaskReqMessage{TaskCode: taskCode})
+	sources, err := TaskServiceClient.TaskSources(ctx, &task.TaskReqMessage{TaskCode: taskCode, ProjectCode: authorizedProject(c)})
 	if err != nil {
 		code, msg := errs.ParseGrpcError(err)
 		c.JSON(http.StatusOK, result.Fail(code, msg))
@@ -669,10 +677,11 @@ func (t *HandlerTask) createComment(c *gin.Context) {
 	// 获取评论参数。
 	req := model.CommentReq{}
 	c.ShouldBind(&req)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	msg := &task.TaskReqMessage{
 		TaskCode:       req.TaskCode,
+		ProjectCode:    authorizedProject(c),
 		CommentContent: req.Comment,
 		Mentions:       req.Mentions,
 		MemberId:       c.GetInt64("memberId"),
@@ -0,0 +1,274 @@
+package project
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/copier"
+	"net/http"
+	"project-api/api/midd"
+	"project-api/pkg/model"
+	"project-api/pkg/model/tasks"
+	"project-common/tms"
+	"project-grpc/task"
+	"time"
+)
+
+// HandlerTaskV2 任务资源的 REST 接口（/api/v2/tasks），约定与 HandlerProjectV2 相同
+type HandlerTaskV2 struct {
+}
+
+func NewTaskV2() *HandlerTaskV2 {
+	return &HandlerTaskV2{}
+}
+
+// list 查询我的任务列表，taskType 1 我执行的、2 我参与的、3 我创建的，type 0 未完成、1 已完成
+func (t *HandlerTaskV2) list(c *gin.Context) {
+	var req tasks.MyTaskReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	page := &model.Page{Page: req.Page, PageSize: req.PageSize}
+	page.Bind(c)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		MemberId: c.GetInt64("memberId"),
+		TaskType: int32(req.TaskType),
+		Type:     int32(req.Type),
+		Page:     page.Page,
+		PageSize: page.PageSize,
+	}
+	rsp, err := TaskServiceClient.MyTaskList(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*tasks.MyTaskDisplay{}
+	copier.Copy(&list, rsp.List)
+	for _, v := range list {
+		v.ProjectInfo = tasks.ProjectInfo{Name: v.ProjectName, Code: v.ProjectCode}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"list":  list,
+		"total": rsp.Total,
+	})
+}
+
+// read 查询任务详情
+func (t *HandlerTaskV2) read(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	td, err := t.detail(ctx, c.Param("taskCode"), authorizedProject(c), c.GetInt64("memberId"))
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, td)
+}
+
+// update 修改任务，只修改请求中出现的字段，返回修改后的任务详情
+func (t *HandlerTaskV2) update(c *gin.Context) {
+	var req tasks.TaskPatchReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	taskCode := c.Param("taskCode")
+	memberId := c.GetInt64("memberId")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	if req.Name != nil {
+		msg := &task.TaskReqMessage{TaskCode: taskCode, ProjectCode: authorizedProject(c), Name: *req.Name, MemberId: memberId}
+		if _, err := TaskServiceClient.EditTask(ctx, msg); err != nil {
+			midd.AbortError(c, err)
+			return
+		}
+	}
+	td, err := t.detail(ctx, taskCode, authorizedProject(c), memberId)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, td)
+}
+
+// move 移动任务到指定阶段的指定位置，返回 204
+func (t *HandlerTaskV2) move(c *gin.Context) {
+	var req tasks.TaskMoveReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.ToStageCode == "" {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		PreTaskCode:  c.Param("taskCode"),
+		NextTaskCode: req.NextTaskCode,
+		ToStageCode:  req.ToStageCode,
+		ProjectCode:  authorizedProject(c),
+		MemberId:     c.GetInt64("memberId"),
+	}
+	if _, err := TaskServiceClient.TaskSort(ctx, msg); err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// members 查询任务成员列表
+func (t *HandlerTaskV2) members(c *gin.Context) {
+	page := &model.Page{}
+	page.Bind(c)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		TaskCode:    c.Param("taskCode"),
+		ProjectCode: authorizedProject(c),
+		MemberId:    c.GetInt64("memberId"),
+		Page:        page.Page,
+		PageSize:    page.PageSize,
+	}
+	rsp, err := TaskServiceClient.ListTaskMember(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*tasks.TaskMember{}
+	copier.Copy(&list, rsp.List)
+	c.JSON(http.StatusOK, gin.H{
+		"list":  list,
+		"total": rsp.Total,
+	})
+}
+
+// logs 查询任务动态，all=1 时查询全部，comment=1 时只查询评论
+func (t *HandlerTaskV2) logs(c *gin.Context) {
+	var req model.TaskLogReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	page := &model.Page{Page: int64(req.Page), PageSize: int64(req.PageSize)}
+	page.Bind(c)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		TaskCode:    c.Param("taskCode"),
+		ProjectCode: authorizedProject(c),
+		MemberId:    c.GetInt64("memberId"),
+		Page:        page.Page,
+		PageSize:    page.PageSize,
+		All:         int32(req.All),
+		Comment:     int32(req.Comment),
+	}
+	rsp, err := TaskServiceClient.TaskLog(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*model.ProjectLogDisplay{}
+	copier.Copy(&list, rsp.List)
+	c.JSON(http.StatusOK, gin.H{
+		"list":  list,
+		"total": rsp.Total,
+	})
+}
+
+// createComment 评论任务，返回 204
+func (t *HandlerTaskV2) createComment(c *gin.Context) {
+	var req tasks.CommentCreateReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.Comment == "" {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		TaskCode:       c.Param("taskCode"),
+		ProjectCode:    authorizedProject(c),
+		CommentContent: req.Comment,
+		Mentions:       req.Mentions,
+		MemberId:       c.GetInt64("memberId"),
+	}
+	if _, err := TaskServiceClient.CreateComment(ctx, msg); err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// workTimes 查询任务工时列表
+func (t *HandlerTaskV2) workTimes(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{TaskCode: c.Param("taskCode"), ProjectCode: authorizedProject(c), MemberId: c.GetInt64("memberId")}
+	rsp, err := TaskServiceClient.TaskWorkTimeList(ctx, msg)
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*model.TaskWorkTime{}
+	copier.Copy(&list, rsp.List)
+	c.JSON(http.StatusOK, list)
+}
+
+// createWorkTime 登记任务工时，返回 204
+func (t *HandlerTaskV2) createWorkTime(c *gin.Context) {
+	var req tasks.WorkTimeCreateReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.Num <= 0 {
+		midd.Abort(c, http.StatusBadRequest, "参数格式有误")
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &task.TaskReqMessage{
+		TaskCode:    c.Param("taskCode"),
+		ProjectCode: authorizedProject(c),
+		MemberId:    c.GetInt64("memberId"),
+		Content:     req.Content,
+		Num:         int32(req.Num),
+		BeginTime:   tms.ParseTime(req.BeginTime),
+	}
+	if _, err := TaskServiceClient.SaveTaskWorkTime(ctx, msg); err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// files 查询任务关联的文件
+func (t *HandlerTaskV2) files(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	rsp, err := TaskServiceClient.TaskSources(ctx, &task.TaskReqMessage{TaskCode: c.Param("taskCode"), ProjectCode: authorizedProject(c)})
+	if err != nil {
+		midd.AbortError(c, err)
+		return
+	}
+	list := []*model.SourceLink{}
+	copier.Copy(&list, rsp.List)
+	c.JSON(http.StatusOK, list)
+}
+
+// detail 查询任务详情
+func (t *HandlerTaskV2) detail(ctx context.Context, taskCode string, projectCode string, memberId int64) (*tasks.TaskDisplay, error) {
+	taskMessage, err := TaskServiceClient.ReadTask(ctx, &task.TaskReqMessage{TaskCode: taskCode, ProjectCode: projectCode, MemberId: memberId})
+	if err != nil {
+		return nil, err
+	}
+	td := &tasks.TaskDisplay{}
+	copier.Copy(td, taskMessage)
+	fillTaskDisplay(td)
+	return td, nil
+}
+
+// fillTaskDisplay 把任务中为空的数组字段初始化为空数组，避免返回 null
+func fillTaskDisplay(td *tasks.TaskDisplay) {
+	if td.Tags == nil {
+		td.Tags = []int{}
+	}
+	if td.ChildCount == nil {
+		td.ChildCount = []int{}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"project-api/config"
 	"project-common/discovery"
 	"project-common/logs"
+	"project-common/tracing"
 	loginServiceV1 "project-grpc/user/login"
 )
 
@@ -15,10 +16,18 @@ import (
 // 该客户端用于与用户登录服务进行通信。
 var LoginServiceClient loginServiceV1.LoginServiceClient
 
+// userRetryMethods 网关调用的用户服务只读方法，服务不可用时可以安全重试
+var userRetryMethods = discovery.RetryMethods{
+	"login.service.v1.LoginService": {
+		"TokenVerify", "FindMemInfoById", "FindMemInfoByIds", "MyOrgList", "Jwks",
+		"ListAccessTokens", "LoginHistory", "ActiveSessions",
+	},
+}
+
 // InitRpcUserClient 初始化 gRPC 用户登录服务客户端。
 // 此函数通过以下步骤完成初始化：
 // 1. 创建一个基于 ETCD 的自定义解析器，用于动态发现服务地址。
-// 2. 使用 insecure 模式建立 gRPC 连接，并指定目标服务为 "etcd:///user"。
+// 2. 使用 insecure 模式建立 gRPC 连接，并指定目标服务为 "etcd:///user"，按服务权重负载均衡。
 // 3. 如果连接成功，则创建并设置全局的 LoginServiceClient。
 func InitRpcUserClient() {
 	// 创建一个基于 ETCD 的自定义解析器，用于动态解析服务地址。
@@ -27,8 +36,7 @@ func InitRpcUserClient() {
 	resolver.Register(etcdRegister)
 
 	// 建立到目标服务的 gRPC 连接，使用 insecure 模式（不验证 TLS）。
-	//conn, err := grpc.Dial("etcd:///user", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	conn, err := grpc.Dial("127.0.0.1:8881", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := discovery.Dial("user", userRetryMethods, config.C.Canary.Services["user"], append(tracing.DialOptions(), grpc.WithTransportCredentials(insecure.NewCredentials()))...)
 	if err != nil {
 		// 如果连接失败，记录致命错误并终止程序运行。
 		log.Fatalf("did not connect: %v", err)
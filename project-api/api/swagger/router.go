@@ -0,0 +1,28 @@
+// Package swagger 网关的接口文档，提供 OpenAPI 3 文档和 Swagger UI
+package swagger
+
+import (
+	"github.com/gin-gonic/gin"
+	"log"
+	"project-api/config"
+	"project-api/router"
+)
+
+// RouterSwagger 接口文档的路由
+type RouterSwagger struct {
+}
+
+// init 注册接口文档的路由
+func init() {
+	log.Println("init swagger router")
+	router.Register(&RouterSwagger{})
+}
+
+// Route 配置开启时提供 /swagger/doc.json 和 /swagger/index.html
+func (*RouterSwagger) Route(r *gin.Engine) {
+	if !config.C.Swagger.Enabled {
+		return
+	}
+	r.GET(docPath, docJson)
+	r.GET(uiPath, ui)
+}
@@ -0,0 +1,186 @@
+package swagger
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"project-api/pkg/model"
+	"project-api/pkg/model/menu"
+	"project-api/pkg/model/pro"
+	"project-api/pkg/model/tasks"
+	"project-api/pkg/model/user"
+	"project-api/pkg/openapi"
+	"project-common/jwts"
+)
+
+// 接口分组
+const (
+	tagLogin        = "登录"
+	tagOrganization = "组织"
+	tagMfa          = "两步验证"
+	tagToken        = "个人访问令牌"
+	tagProfile      = "个人资料"
+	tagSession      = "登录记录"
+	tagAccount      = "账号"
+	tagProject      = "项目"
+	tagTask         = "任务"
+	tagDepartment   = "部门"
+	tagAuth         = "权限"
+	tagMenu         = "菜单"
+	tagProjectV2    = "项目 v2"
+	tagTaskV2       = "任务 v2"
+)
+
+// 分页查询的参数，和 model.Page 一起嵌入请求参数
+type (
+	pageOnly = model.Page
+	codePage struct {
+		model.Page
+		ProjectCode string `form:"projectCode"`
+	}
+)
+
+// listPage 分页列表的响应
+func listPage(list any) gin.H {
+	return gin.H{"list": list, "total": int64(0), "page": int64(0)}
+}
+
+// restList REST 接口分页列表的响应
+func restList(list any) gin.H {
+	return gin.H{"list": list, "total": int64(0)}
+}
+
+// routes 网关的所有接口，新增接口时需要同时在这里补充文档，否则 TestOpenApiCoversRoutes 会失败
+var routes = []openapi.Route{
+	// 用户模块：未登录即可访问的接口
+	{Method: http.MethodGet, Path: "/.well-known/jwks.json", Tag: tagLogin, Summary: "获取校验访问令牌的公钥", Raw: true, Response: jwts.JWKS{}},
+	{Method: http.MethodPost, Path: "/project/login/getCaptcha", Tag: tagLogin, Summary: "获取注册验证码", Request: gin.H{"mobile": ""}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/login/register", Tag: tagLogin, Summary: "注册", Request: user.RegisterReq{}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/login", Tag: tagLogin, Summary: "登录，开启两步验证时只返回 mfaTicket", Request: user.LoginReq{}, Response: user.LoginRsp{}},
+	{Method: http.MethodPost, Path: "/project/login/refresh", Tag: tagLogin, Summary: "刷新令牌", Request: user.RefreshTokenReq{}, Response: user.TokenList{}},
+	{Method: http.MethodPost, Path: "/project/login/logout", Tag: tagLogin, Summary: "退出登录，all=true 时退出所有设备", Request: user.LogoutReq{}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/login/getResetCode", Tag: tagLogin, Summary: "获取找回密码验证码", Request: user.ResetCodeReq{}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/login/resetPassword", Tag: tagLogin, Summary: "重置密码", Request: user.ResetPasswordReq{}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/login/mfa", Tag: tagLogin, Summary: "使用登录票据和动态码完成两步验证登录", Request: user.LoginMfaReq{}, Response: user.LoginRsp{}},
+
+	// 用户模块：组织
+	{Method: http.MethodPost, Path: "/project/organization/_getOrgList", Tag: tagOrganization, Summary: "我的组织列表", Auth: true, Response: []*user.OrganizationList{}},
+	{Method: http.MethodPost, Path: "/project/organization/switch", Tag: tagOrganization, Summary: "切换当前组织，返回携带新组织的令牌", Auth: true, Request: user.SwitchOrganizationReq{}, Response: user.LoginRsp{}},
+	{Method: http.MethodPost, Path: "/project/organization/create", Tag: tagOrganization, Summary: "创建团队组织", Auth: true, Request: user.CreateOrganizationReq{}, Response: user.OrganizationList{}},
+	{Method: http.MethodPost, Path: "/project/organization/invite", Tag: tagOrganization, Summary: "生成组织邀请码", Auth: true, Request: user.OrgInviteReq{}, Response: user.OrgInviteRsp{}},
+	{Method: http.MethodPost, Path: "/project/organization/join", Tag: tagOrganization, Summary: "使用邀请码加入组织", Auth: true, Request: user.OrgInviteReq{}, Response: user.OrganizationList{}},
+	{Method: http.MethodPost, Path: "/project/organization/removeMember", Tag: tagOrganization, Summary: "移除组织成员", Auth: true, Request: user.OrgMemberReq{}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/organization/transfer", Tag: tagOrganization, Summary: "转让组织", Auth: true, Request: user.OrgMemberReq{}, Response: ""},
+
+	// 用户模块：两步验证
+	{Method: http.MethodPost, Path: "/project/mfa/enroll", Tag: tagMfa, Summary: "生成两步验证密钥", Auth: true, Response: user.MfaEnrollRsp{}},
+	{Method: http.MethodPost, Path: "/project/mfa/confirm", Tag: tagMfa, Summary: "确认开启两步验证，返回恢复码", Auth: true, Request: user.MfaReq{}, Response: []string{}},
+	{Method: http.MethodPost, Path: "/project/mfa/disable", Tag: tagMfa, Summary: "关闭两步验证", Auth: true, Request: user.MfaReq{}, Response: ""},
+
+	// 用户模块：个人访问令牌
+	{Method: http.MethodPost, Path: "/project/token/create", Tag: tagToken, Summary: "创建个人访问令牌，令牌明文只返回这一次", Auth: true, Request: user.AccessTokenReq{}, Response: user.AccessTokenRsp{}},
+	{Method: http.MethodPost, Path: "/project/token/list", Tag: tagToken, Summary: "个人访问令牌列表", Auth: true, Response: []*user.AccessToken{}},
+	{Method: http.MethodPost, Path: "/project/token/revoke", Tag: tagToken, Summary: "撤销个人访问令牌", Auth: true, Request: user.AccessTokenReq{}, Response: ""},
+
+	// 用户模块：个人资料
+	{Method: http.MethodPost, Path: "/project/profile/update", Tag: tagProfile, Summary: "修改个人资料", Auth: true, Request: user.ProfileReq{}, Response: user.Member{}},
+	{Method: http.MethodPost, Path: "/project/profile/changePassword", Tag: tagProfile, Summary: "修改密码", Auth: true, Request: user.ChangePasswordReq{}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/profile/getChangeCode", Tag: tagProfile, Summary: "获取修改手机号或邮箱的验证码", Auth: true, Request: user.ChangeContactReq{}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/profile/changeMobile", Tag: tagProfile, Summary: "使用验证码修改手机号", Auth: true, Request: user.ChangeContactReq{}, Response: user.Member{}},
+	{Method: http.MethodPost, Path: "/project/profile/changeEmail", Tag: tagProfile, Summary: "使用验证码修改邮箱", Auth: true, Request: user.ChangeContactReq{}, Response: user.Member{}},
+
+	// 用户模块：登录记录和会话
+	{Method: http.MethodPost, Path: "/project/session/history", Tag: tagSession, Summary: "登录记录", Auth: true, Request: user.LoginLogReq{}, Response: listPage([]*user.LoginLog{})},
+	{Method: http.MethodPost, Path: "/project/session/list", Tag: tagSession, Summary: "活跃会话列表", Auth: true, Response: []*user.Session{}},
+	{Method: http.MethodPost, Path: "/project/session/revoke", Tag: tagSession, Summary: "下线会话", Auth: true, Request: user.LoginLogReq{}, Response: ""},
+
+	// 用户模块：账号
+	{Method: http.MethodPost, Path: "/project/account/unlock", Tag: tagAccount, Summary: "解除成员账号的登录锁定", Auth: true, Request: user.UnlockMemberReq{}, Response: ""},
+
+	// 项目模块
+	{Method: http.MethodPost, Path: "/project/index", Tag: tagProject, Summary: "首页菜单", Auth: true, Response: []*menu.Menu{}},
+	{Method: http.MethodPost, Path: "/project/project/selfList", Tag: tagProject, Summary: "我的项目列表", Auth: true, Request: struct {
+		model.Page
+		SelectBy string `form:"selectBy"`
+	}{}, Response: gin.H{"list": []*pro.ProjectAndMember{}, "total": int64(0)}},
+	{Method: http.MethodPost, Path: "/project/project", Tag: tagProject, Summary: "项目列表", Auth: true, Request: struct {
+		model.Page
+		SelectBy string `form:"selectBy"`
+	}{}, Response: gin.H{"list": []*pro.ProjectAndMember{}, "total": int64(0)}},
+	{Method: http.MethodPost, Path: "/project/project_template", Tag: tagProject, Summary: "项目模板列表", Auth: true, Request: struct {
+		model.Page
+		ViewType int `form:"viewType"`
+	}{}, Response: gin.H{"list": []*pro.ProjectTemplate{}, "total": int64(0)}},
+	{Method: http.MethodPost, Path: "/project/project/save", Tag: tagProject, Summary: "创建项目", Auth: true, Request: pro.SaveProjectRequest{}, Response: pro.SaveProject{}},
+	{Method: http.MethodPost, Path: "/project/project/read", Tag: tagProject, Summary: "项目详情", Auth: true, Request: gin.H{"projectCode": ""}, Response: pro.ProjectDetail{}},
+	{Method: http.MethodPost, Path: "/project/project/recycle", Tag: tagProject, Summary: "项目移入回收站", Auth: true, Request: gin.H{"projectCode": ""}, Response: []int{}},
+	{Method: http.MethodPost, Path: "/project/project/recovery", Tag: tagProject, Summary: "从回收站恢复项目", Auth: true, Request: gin.H{"projectCode": ""}, Response: []int{}},
+	{Method: http.MethodPost, Path: "/project/project_collect/collect", Tag: tagProject, Summary: "收藏或取消收藏项目，type 为 collect 或 cancel", Auth: true, Request: gin.H{"projectCode": "", "type": ""}, Response: []int{}},
+	{Method: http.MethodPost, Path: "/project/project/edit", Tag: tagProject, Summary: "编辑项目", Auth: true, Request: pro.ProjectReq{}, Response: []int{}},
+	{Method: http.MethodPost, Path: "/project/project/getLogBySelfProject", Tag: tagProject, Summary: "我参与的项目的动态", Auth: true, Request: pageOnly{}, Response: []*model.ProjectLog{}},
+	{Method: http.MethodPost, Path: "/project/project_member/index", Tag: tagProject, Summary: "项目成员列表", Auth: true, Request: codePage{}, Response: listPage([]*pro.MemberProjectResp{})},
+	{Method: http.MethodPost, Path: "/project/project_member/role", Tag: tagProject, Summary: "修改项目成员的角色", Auth: true, Request: pro.ProjectMemberRoleReq{}, Response: []int{}},
+
+	// 项目模块：任务
+	{Method: http.MethodPost, Path: "/project/task_stages", Tag: tagTask, Summary: "任务阶段列表", Auth: true, Request: codePage{}, Response: listPage([]*tasks.TaskStagesResp{})},
+	{Method: http.MethodPost, Path: "/project/task_stages/tasks", Tag: tagTask, Summary: "任务阶段下的任务列表", Auth: true, Request: gin.H{"stageCode": ""}, Response: []*tasks.TaskDisplay{}},
+	{Method: http.MethodPost, Path: "/project/task/save", Tag: tagTask, Summary: "创建任务", Auth: true, Request: tasks.TaskSaveReq{}, Response: tasks.TaskDisplay{}},
+	{Method: http.MethodPost, Path: "/project/task/edit", Tag: tagTask, Summary: "修改任务", Auth: true, Request: tasks.TaskEditReq{}, Response: ""},
+	{Method: http.MethodPost, Path: "/project/task/sort", Tag: tagTask, Summary: "任务排序和移动到其他阶段", Auth: true, Request: tasks.TaskSortReq{}, Response: []int{}},
+	{Method: http.MethodPost, Path: "/project/task/selfList", Tag: tagTask, Summary: "我的任务列表", Auth: true, Request: tasks.MyTaskReq{}, Response: gin.H{"list": []*tasks.MyTaskDisplay{}, "total": int64(0)}},
+	{Method: http.MethodPost, Path: "/project/task/read", Tag: tagTask, Summary: "任务详情", Auth: true, Request: gin.H{"taskCode": ""}, Response: tasks.TaskDisplay{}},
+	{Method: http.MethodPost, Path: "/project/task_member", Tag: tagTask, Summary: "任务成员列表", Auth: true, Request: struct {
+		model.Page
+		TaskCode string `form:"taskCode"`
+	}{}, Response: listPage([]*tasks.TaskMember{})},
+	{Method: http.MethodPost, Path: "/project/task/taskLog", Tag: tagTask, Summary: "任务动态", Auth: true, Request: model.TaskLogReq{}, Response: listPage([]*model.ProjectLogDisplay{})},
+	{Method: http.MethodPost, Path: "/project/task/_taskWorkTimeList", Tag: tagTask, Summary: "任务工时列表", Auth: true, Request: gin.H{"taskCode": ""}, Response: []*model.TaskWorkTime{}},
+	{Method: http.MethodPost, Path: "/project/task/saveTaskWorkTime", Tag: tagTask, Summary: "登记任务工时", Auth: true, Request: model.SaveTaskWorkTimeReq{}, Response: []int{}},
+	{Method: http.MethodPost, Path: "/project/file/uploadFiles", Tag: tagTask, Summary: "分片上传任务附件", Auth: true, Request: struct {
+		model.UploadFileReq
+		File openapi.File `form:"file"`
+	}{}, Response: gin.H{"file": "", "hash": "", "key": "", "url": "", "projectName": ""}},
+	{Method: http.MethodPost, Path: "/project/task/taskSources", Tag: tagTask, Summary: "任务关联的文件", Auth: true, Request: gin.H{"taskCode": ""}, Response: []*model.SourceLink{}},
+	{Method: http.MethodPost, Path: "/project/task/createComment", Tag: tagTask, Summary: "评论任务", Auth: true, Request: model.CommentReq{}, Response: true},
+
+	// 项目模块：组织成员、部门、权限和菜单
+	{Method: http.MethodPost, Path: "/project/account", Tag: tagAccount, Summary: "组织成员列表", Auth: true, Request: model.AccountReq{}, Response: gin.H{"list": []*model.MemberAccount{}, "authList": []*model.ProjectAuth{}, "total": int64(0), "page": int64(0)}},
+	{Method: http.MethodPost, Path: "/project/department", Tag: tagDepartment, Summary: "部门列表", Auth: true, Request: model.DepartmentReq{}, Response: listPage([]*model.Department{})},
+	{Method: http.MethodPost, Path: "/project/department/save", Tag: tagDepartment, Summary: "创建部门", Auth: true, Request: model.DepartmentReq{}, Response: model.Department{}},
+	{Method: http.MethodPost, Path: "/project/department/read", Tag: tagDepartment, Summary: "部门详情", Auth: true, Request: gin.H{"departmentCode": ""}, Response: model.Department{}},
+	{Method: http.MethodPost, Path: "/project/auth", Tag: tagAuth, Summary: "角色列表", Auth: true, Request: pageOnly{}, Response: listPage([]*model.ProjectAuth{})},
+	{Method: http.MethodPost, Path: "/project/auth/apply", Tag: tagAuth, Summary: "查询或保存角色的权限节点，action 为 getnode 或 save", Auth: true, Request: model.ProjectAuthReq{}, Response: gin.H{"list": []*model.ProjectNodeAuthTree{}, "checkedList": []string{}}},
+	{Method: http.MethodPost, Path: "/project/menu/menu", Tag: tagMenu, Summary: "菜单列表", Auth: true, Response: []*model.Menu{}},
+
+	// REST 接口：请求体为 JSON，响应不包装，失败时返回对应的 HTTP 状态码和 {code, msg}
+	{Method: http.MethodGet, Path: "/api/v2/projects", Tag: tagProjectV2, Summary: "项目列表，selectBy 为 my、archive、deleted 或 collect", Auth: true, Raw: true, Query: struct {
+		model.Page
+		SelectBy string `form:"selectBy"`
+	}{}, Response: restList([]*pro.ProjectAndMember{})},
+	{Method: http.MethodPost, Path: "/api/v2/projects", Tag: tagProjectV2, Summary: "创建项目", Auth: true, Raw: true, JSON: true, Status: http.StatusCreated, Request: pro.SaveProjectRequest{}, Response: pro.SaveProject{}},
+	{Method: http.MethodGet, Path: "/api/v2/projects/:projectCode", Tag: tagProjectV2, Summary: "项目详情", Auth: true, Raw: true, Response: pro.ProjectDetail{}},
+	{Method: http.MethodPatch, Path: "/api/v2/projects/:projectCode", Tag: tagProjectV2, Summary: "修改项目，只修改请求中出现的字段", Auth: true, Raw: true, JSON: true, Request: pro.ProjectPatchReq{}, Response: pro.ProjectDetail{}},
+	{Method: http.MethodDelete, Path: "/api/v2/projects/:projectCode", Tag: tagProjectV2, Summary: "项目移入回收站", Auth: true, Raw: true, Status: http.StatusNoContent},
+	{Method: http.MethodPost, Path: "/api/v2/projects/:projectCode/recovery", Tag: tagProjectV2, Summary: "从回收站恢复项目", Auth: true, Raw: true, Status: http.StatusNoContent},
+	{Method: http.MethodPut, Path: "/api/v2/projects/:projectCode/collect", Tag: tagProjectV2, Summary: "收藏项目", Auth: true, Raw: true, Status: http.StatusNoContent},
+	{Method: http.MethodDelete, Path: "/api/v2/projects/:projectCode/collect", Tag: tagProjectV2, Summary: "取消收藏项目", Auth: true, Raw: true, Status: http.StatusNoContent},
+	{Method: http.MethodGet, Path: "/api/v2/projects/:projectCode/members", Tag: tagProjectV2, Summary: "项目成员列表", Auth: true, Raw: true, Query: pageOnly{}, Response: restList([]*pro.MemberProjectResp{})},
+	{Method: http.MethodPatch, Path: "/api/v2/projects/:projectCode/members/:memberCode", Tag: tagProjectV2, Summary: "修改项目成员的角色", Auth: true, Raw: true, JSON: true, Status: http.StatusNoContent, Request: pro.ProjectMemberPatchReq{}},
+	{Method: http.MethodGet, Path: "/api/v2/projects/:projectCode/stages", Tag: tagProjectV2, Summary: "任务阶段列表", Auth: true, Raw: true, Query: pageOnly{}, Response: restList([]*tasks.TaskStagesResp{})},
+	{Method: http.MethodGet, Path: "/api/v2/projects/:projectCode/stages/:stageCode/tasks", Tag: tagProjectV2, Summary: "任务阶段下的任务列表", Auth: true, Raw: true, Response: []*tasks.TaskDisplay{}},
+	{Method: http.MethodPost, Path: "/api/v2/projects/:projectCode/stages/:stageCode/tasks", Tag: tagProjectV2, Summary: "在任务阶段下创建任务", Auth: true, Raw: true, JSON: true, Status: http.StatusCreated, Request: tasks.TaskCreateReq{}, Response: tasks.TaskDisplay{}},
+	{Method: http.MethodGet, Path: "/api/v2/projects/:projectCode/events", Tag: tagProjectV2, Summary: "订阅看板变化事件（Server-Sent Events），EventSource 可以用查询参数 access_token 携带 Token", Auth: true, Raw: true, Stream: true, Response: tasks.BoardEvent{}},
+	{Method: http.MethodGet, Path: "/api/v2/tasks", Tag: tagTaskV2, Summary: "我的任务列表，taskType 1 我执行的、2 我参与的、3 我创建的", Auth: true, Raw: true, Query: tasks.MyTaskReq{}, Response: restList([]*tasks.MyTaskDisplay{})},
+	{Method: http.MethodGet, Path: "/api/v2/tasks/:taskCode", Tag: tagTaskV2, Summary: "任务详情", Auth: true, Raw: true, Response: tasks.TaskDisplay{}},
+	{Method: http.MethodPatch, Path: "/api/v2/tasks/:taskCode", Tag: tagTaskV2, Summary: "修改任务，只修改请求中出现的字段", Auth: true, Raw: true, JSON: true, Request: tasks.TaskPatchReq{}, Response: tasks.TaskDisplay{}},
+	{Method: http.MethodPost, Path: "/api/v2/tasks/:taskCode/move", Tag: tagTaskV2, Summary: "移动任务到指定阶段的指定任务之前", Auth: true, Raw: true, JSON: true, Status: http.StatusNoContent, Request: tasks.TaskMoveReq{}},
+	{Method: http.MethodGet, Path: "/api/v2/tasks/:taskCode/members", Tag: tagTaskV2, Summary: "任务成员列表", Auth: true, Raw: true, Query: pageOnly{}, Response: restList([]*tasks.TaskMember{})},
+	{Method: http.MethodGet, Path: "/api/v2/tasks/:taskCode/logs", Tag: tagTaskV2, Summary: "任务动态", Auth: true, Raw: true, Query: struct {
+		model.Page
+		All     int `form:"all"`
+		Comment int `form:"comment"`
+	}{}, Response: restList([]*model.ProjectLogDisplay{})},
+	{Method: http.MethodPost, Path: "/api/v2/tasks/:taskCode/comments", Tag: tagTaskV2, Summary: "评论任务", Auth: true, Raw: true, JSON: true, Status: http.StatusNoContent, Request: tasks.CommentCreateReq{}},
+	{Method: http.MethodGet, Path: "/api/v2/tasks/:taskCode/workTimes", Tag: tagTaskV2, Summary: "任务工时列表", Auth: true, Raw: true, Response: []*model.TaskWorkTime{}},
+	{Method: http.MethodPost, Path: "/api/v2/tasks/:taskCode/workTimes", Tag: tagTaskV2, Summary: "登记任务工时", Auth: true, Raw: true, JSON: true, Status: http.StatusNoContent, Request: tasks.WorkTimeCreateReq{}},
+	{Method: http.MethodGet, Path: "/api/v2/tasks/:taskCode/files", Tag: tagTaskV2, Summary: "任务关联的文件", Auth: true, Raw: true, Response: []*model.SourceLink{}},
+}
@@ -0,0 +1,62 @@
+package swagger
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"project-api/pkg/openapi"
+	"sync"
+)
+
+// 接口文档的访问路径
+const (
+	docPath = "/swagger/doc.json"
+	uiPath  = "/swagger/index.html"
+)
+
+var (
+	doc     *openapi.Document
+	docOnce sync.Once
+)
+
+// Doc 返回根据 routes 生成的接口文档，只生成一次
+func Doc() *openapi.Document {
+	docOnce.Do(func() {
+		doc = openapi.Build(openapi.Info{
+			Title:       "ms_project API",
+			Description: "项目管理网关接口。登录后的接口在请求头中携带 Authorization: bearer {accessToken}，响应统一为 {code, msg, data}，code 为 200 表示成功。",
+			Version:     "1.0.0",
+		}, routes)
+	})
+	return doc
+}
+
+// Ignored 不需要写入接口文档的路由：接口文档自身
+func Ignored(path string) bool {
+	return path == docPath || path == uiPath
+}
+
+// docJson 返回 OpenAPI 文档
+func docJson(c *gin.Context) {
+	c.JSON(http.StatusOK, Doc())
+}
+
+// ui 返回 Swagger UI 页面，页面资源从 CDN 加载
+func ui(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(uiHtml))
+}
+
+const uiHtml = `<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+  <meta charset="utf-8"/>
+  <title>ms_project API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css"/>
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+<script>
+  window.ui = SwaggerUIBundle({url: "` + docPath + `", dom_id: "#swagger-ui"});
+</script>
+</body>
+</html>`
@@ -25,16 +25,88 @@ func init() {
 func (*RouterUser) Route(r *gin.Engine) {
 	//初始化grpc的客户端连接
 	rpc.InitRpcUserClient()
+	// 获取校验访问令牌的公钥，并对外发布
+	midd.InitJwks()
+	r.GET("/.well-known/jwks.json", midd.Jwks)
+	// 订阅令牌校验缓存的失效通知
+	midd.InitMemberCache()
 	h := New()
+	// 登录相关的接口未登录即可访问，按IP限流
+	limit := midd.RateLimit()
 	// 定义登录验证码获取的API路由，使用POST方法
-	r.POST("/project/login/getCaptcha", h.getCaptcha)
+	r.POST("/project/login/getCaptcha", limit, h.getCaptcha)
 	// 定义用户注册的API路由，使用POST方法
-	r.POST("/project/login/register", h.register)
+	r.POST("/project/login/register", limit, h.register)
 	// 定义用户登录的API路由，使用POST方法
-	r.POST("/project/login", h.login)
+	r.POST("/project/login", limit, h.login)
+	// 定义刷新令牌的API路由，使用POST方法
+	r.POST("/project/login/refresh", limit, h.refreshToken)
+	// 定义退出登录的API路由，all=true 时退出所有设备
+	r.POST("/project/login/logout", limit, h.logout)
+	// 定义找回密码的API路由，先获取验证码再重置密码
+	r.POST("/project/login/getResetCode", limit, h.getResetCode)
+	r.POST("/project/login/resetPassword", limit, h.resetPassword)
+	// 定义两步验证登录的API路由，使用登录返回的票据和动态码换取令牌
+	r.POST("/project/login/mfa", limit, h.loginMfa)
 	// 定义用户退出登录的API路由，使用POST方法
 	org := r.Group("/project/organization")
 	// 使用TokenVerify中间件对组织列表的API进行身份验证
 	org.Use(midd.TokenVerify())
+	org.Use(midd.Canary())
+	org.Use(midd.RateLimit())
+	org.Use(midd.Idempotency())
 	org.POST("/_getOrgList", h.myOrgList)
+	// 切换当前组织，返回携带新组织的令牌
+	org.POST("/switch", h.switchOrganization)
+	// 团队组织：创建、邀请、加入、移除成员和转让
+	org.POST("/create", h.createOrganization)
+	org.POST("/invite", h.createOrgInvite)
+	org.POST("/join", h.acceptOrgInvite)
+	org.POST("/removeMember", h.removeOrgMember)
+	org.POST("/transfer", h.transferOrganization)
+	// 两步验证管理的API需要登录后才能访问
+	mfa := r.Group("/project/mfa")
+	mfa.Use(midd.TokenVerify())
+	mfa.Use(midd.Canary())
+	mfa.Use(midd.RateLimit())
+	mfa.Use(midd.Idempotency())
+	mfa.POST("/enroll", h.mfaEnroll)
+	mfa.POST("/confirm", h.mfaConfirm)
+	mfa.POST("/disable", h.mfaDisable)
+	// 个人访问令牌管理的API需要登录后才能访问，不能使用个人访问令牌调用
+	token := r.Group("/project/token")
+	token.Use(midd.TokenVerify())
+	token.Use(midd.Canary())
+	token.Use(midd.RateLimit())
+	token.Use(midd.Idempotency())
+	token.POST("/create", h.createAccessToken)
+	token.POST("/list", h.listAccessTokens)
+	token.POST("/revoke", h.revokeAccessToken)
+	// 个人资料自助修改的API需要登录后才能访问
+	profile := r.Group("/project/profile")
+	profile.Use(midd.TokenVerify())
+	profile.Use(midd.Canary())
+	profile.Use(midd.RateLimit())
+	profile.Use(midd.Idempotency())
+	profile.POST("/update", h.updateProfile)
+	profile.POST("/changePassword", h.changePassword)
+	profile.POST("/getChangeCode", h.getChangeCode)
+	profile.POST("/changeMobile", h.changeMobile)
+	profile.POST("/changeEmail", h.changeEmail)
+	// 登录记录和活跃会话
+	session := r.Group("/project/session")
+	session.Use(midd.TokenVerify())
+	session.Use(midd.Canary())
+	session.Use(midd.RateLimit())
+	session.Use(midd.Idempotency())
+	session.POST("/history", h.loginHistory)
+	session.POST("/list", h.activeSessions)
+	session.POST("/revoke", h.revokeSession)
+	// 管理员解除成员账号的登录锁定
+	account := r.Group("/project/account")
+	account.Use(midd.TokenVerify())
+	account.Use(midd.Canary())
+	account.Use(midd.RateLimit())
+	account.Use(midd.Idempotency())
+	account.POST("/unlock", h.unlockMember)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/jinzhu/copier"
+	"google.golang.org/grpc"
 	"log"
 	"net/http"
 	"project-api/api/rpc"
@@ -12,6 +13,7 @@ import (
 	common "project-common"
 	"project-common/errs"
 	"project-grpc/user/login"
+	"strings"
 	"time"
 )
 
@@ -59,11 +61,11 @@ func (*HandlerUser) getCaptcha(ctx *gin.Context) {
 	mobile := ctx.PostForm("mobile")
 
 	// 创建一个带有超时的context，以确保操作不会无限期地进行。
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 调用gRPC服务获取验证码。
-	rsp, err := rpc.LoginServiceClient.GetCaptcha(c, &login.CaptchaMessage{Mobile: mobile})
+	rsp, err := rpc.LoginServiceClient.GetCaptcha(c, &login.CaptchaMessage{Mobile: mobile, Ip: GetIp(ctx)})
 	if err != nil {
 		// 如果发生错误，解析gRPC错误以获取错误代码和消息。
 		code, msg := errs.ParseGrpcError(err)
@@ -72,7 +74,7 @@ func (*HandlerUser) getCaptcha(ctx *gin.Context) {
 		return
 	}
 
-	// 如果成功，返回成功响应，包含验证码。
+	// 如果成功，返回成功响应，非开发模式下验证码为空。
 	ctx.JSON(http.StatusOK, result.Success(rsp.Code))
 }
 
@@ -98,7 +100,7 @@ func (u *HandlerUser) register(c *gin.Context) {
 	}
 
 	// 创建一个带有超时的context，用于调用GRPC服务
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
 	// 创建注册消息实例，并将请求参数复制到消息中，准备调用GRPC服务
@@ -141,7 +143,7 @@ func (u *HandlerUser) login(c *gin.Context) {
 
 	//2.调用user grpc 完成登录
 	// 创建一个带有超时的上下文，以防止登录请求处理时间过长
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 	// 初始化登录消息对象，用于调用gRPC服务
 	msg := &login.LoginMessage{}
@@ -153,6 +155,7 @@ func (u *HandlerUser) login(c *gin.Context) {
 	}
 	// TODO IP加入
 	msg.Ip = GetIp(c)
+	msg.UserAgent = c.Request.UserAgent()
 	// 调用gRPC服务的Login方法进行登录，如果登录失败，则解析错误信息并返回
 	loginRsp, err := rpc.LoginServiceClient.Login(ctx, msg)
 	if err != nil {
@@ -174,6 +177,581 @@ func (u *HandlerUser) login(c *gin.Context) {
 	c.JSON(http.StatusOK, result.Success(rsp))
 }
 
+// refreshToken 使用刷新令牌换取新的令牌对
+// 旧的刷新令牌在使用后即作废，重复使用会导致该次登录产生的所有令牌失效
+func (u *HandlerUser) refreshToken(c *gin.Context) {
+	result := &common.Result{}
+	var req user.RefreshTokenReq
+	err := c.ShouldBind(&req)
+	if err != nil || req.RefreshToken == "" {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.RefreshTokenMessage{RefreshToken: req.RefreshToken, Ip: GetIp(c)}
+	tokenMsg, err := rpc.LoginServiceClient.RefreshToken(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	rsp := &user.TokenList{}
+	copier.Copy(rsp, tokenMsg)
+	c.JSON(http.StatusOK, result.Success(rsp))
+}
+
+// logout 退出登录
+// 使用请求头中的访问令牌退出当前登录，all 为 true 时退出该用户在所有设备上的登录
+func (u *HandlerUser) logout(c *gin.Context) {
+	result := &common.Result{}
+	var req user.LogoutReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.LogoutMessage{Token: c.GetHeader("Authorization")}
+	var err error
+	if req.All {
+		_, err = rpc.LoginServiceClient.LogoutAll(ctx, msg)
+	} else {
+		_, err = rpc.LoginServiceClient.Logout(ctx, msg)
+	}
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(""))
+}
+
+// getResetCode 获取找回密码的验证码，验证码发送到注册时填写的手机号或邮箱
+func (u *HandlerUser) getResetCode(c *gin.Context) {
+	result := &common.Result{}
+	var req user.ResetCodeReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	rsp, err := rpc.LoginServiceClient.SendResetCode(ctx, &login.ResetCodeMessage{Account: req.Account, Ip: GetIp(c)})
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(rsp.Code))
+}
+
+// resetPassword 使用验证码重置密码，重置后需要重新登录
+func (u *HandlerUser) resetPassword(c *gin.Context) {
+	result := &common.Result{}
+	var req user.ResetPasswordReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	if err := req.Verify(); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, err.Error()))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.ResetPasswordMessage{Account: req.Account, Captcha: req.Captcha, Password: req.Password}
+	if _, err := rpc.LoginServiceClient.ResetPassword(ctx, msg); err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(""))
+}
+
+// loginMfa 两步验证登录，使用登录接口返回的票据和动态码（或恢复码）换取令牌
+func (u *HandlerUser) loginMfa(c *gin.Context) {
+	result := &common.Result{}
+	var req user.LoginMfaReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.LoginMfaMessage{Ticket: req.Ticket, Code: req.Code, Ip: GetIp(c), UserAgent: c.Request.UserAgent()}
+	loginRsp, err := rpc.LoginServiceClient.LoginMfa(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	rsp := &user.LoginRsp{}
+	copier.Copy(rsp, loginRsp)
+	c.JSON(http.StatusOK, result.Success(rsp))
+}
+
+// mfaEnroll 开始开启两步验证，返回密钥和用于生成二维码的 otpauth 地址
+func (u *HandlerUser) mfaEnroll(c *gin.Context) {
+	result := &common.Result{}
+	memberId := c.GetInt64("memberId")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	enrollRsp, err := rpc.LoginServiceClient.MfaEnroll(ctx, &login.MfaMessage{MemId: memberId})
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(&user.MfaEnrollRsp{Secret: enrollRsp.Secret, Uri: enrollRsp.Uri}))
+}
+
+// mfaConfirm 提交动态码确认开启两步验证，返回只展示一次的恢复码
+func (u *HandlerUser) mfaConfirm(c *gin.Context) {
+	result := &common.Result{}
+	var req user.MfaReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	memberId := c.GetInt64("memberId")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	confirmRsp, err := rpc.LoginServiceClient.MfaConfirm(ctx, &login.MfaMessage{MemId: memberId, Code: req.Code})
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(confirmRsp.RecoveryCodes))
+}
+
+// mfaDisable 提交动态码或恢复码关闭两步验证
+func (u *HandlerUser) mfaDisable(c *gin.Context) {
+	result := &common.Result{}
+	var req user.MfaReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	memberId := c.GetInt64("memberId")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	if _, err := rpc.LoginServiceClient.MfaDisable(ctx, &login.MfaMessage{MemId: memberId, Code: req.Code}); err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(""))
+}
+
+// createAccessToken 创建个人访问令牌，令牌明文只在本次响应中返回
+func (u *HandlerUser) createAccessToken(c *gin.Context) {
+	result := &common.Result{}
+	var req user.AccessTokenReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	memberId := c.GetInt64("memberId")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.AccessTokenMessage{
+		MemId:      memberId,
+		Name:       req.Name,
+		Scopes:     splitScopes(req.Scopes),
+		ExpireDays: req.ExpireDays,
+	}
+	tokenRsp, err := rpc.LoginServiceClient.CreateAccessToken(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	rsp := &user.AccessTokenRsp{Token: tokenRsp.Token, Info: &user.AccessToken{}}
+	copier.Copy(rsp.Info, tokenRsp.Info)
+	c.JSON(http.StatusOK, result.Success(rsp))
+}
+
+// listAccessTokens 查询当前用户的个人访问令牌列表
+func (u *HandlerUser) listAccessTokens(c *gin.Context) {
+	result := &common.Result{}
+	memberId := c.GetInt64("memberId")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	listRsp, err := rpc.LoginServiceClient.ListAccessTokens(ctx, &login.UserMessage{MemId: memberId})
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	var list []*user.AccessToken
+	copier.Copy(&list, listRsp.List)
+	if list == nil {
+		list = []*user.AccessToken{}
+	}
+	c.JSON(http.StatusOK, result.Success(list))
+}
+
+// revokeAccessToken 吊销个人访问令牌
+func (u *HandlerUser) revokeAccessToken(c *gin.Context) {
+	result := &common.Result{}
+	var req user.AccessTokenReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	memberId := c.GetInt64("memberId")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	if _, err := rpc.LoginServiceClient.RevokeAccessToken(ctx, &login.AccessTokenMessage{MemId: memberId, Code: req.Code}); err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(""))
+}
+
+// unlockMember 组织管理员解除成员账号的登录锁定
+func (u *HandlerUser) unlockMember(c *gin.Context) {
+	result := &common.Result{}
+	var req user.UnlockMemberReq
+	if err := c.ShouldBind(&req); err != nil || req.Account == "" {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.UnlockMemberMessage{
+		MemId:            c.GetInt64("memberId"),
+		OrganizationCode: c.GetString("organizationCode"),
+		Account:          req.Account,
+	}
+	if _, err := rpc.LoginServiceClient.UnlockMember(ctx, msg); err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(""))
+}
+
+// switchOrganization 切换当前组织，前端需要用返回的令牌替换之前的令牌
+func (u *HandlerUser) switchOrganization(c *gin.Context) {
+	result := &common.Result{}
+	var req user.SwitchOrganizationReq
+	if err := c.ShouldBind(&req); err != nil || req.OrganizationCode == "" {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.SwitchOrganizationMessage{
+		Token:            c.GetHeader("Authorization"),
+		OrganizationCode: req.OrganizationCode,
+		Ip:               GetIp(c),
+		UserAgent:        c.Request.UserAgent(),
+	}
+	loginRsp, err := rpc.LoginServiceClient.SwitchOrganization(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	rsp := &user.LoginRsp{}
+	copier.Copy(rsp, loginRsp)
+	c.JSON(http.StatusOK, result.Success(rsp))
+}
+
+// createOrganization 创建团队组织，当前用户成为组织的拥有者
+func (u *HandlerUser) createOrganization(c *gin.Context) {
+	result := &common.Result{}
+	var req user.CreateOrganizationReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.CreateOrganizationMessage{
+		MemId:       c.GetInt64("memberId"),
+		Name:        req.Name,
+		Avatar:      req.Avatar,
+		Description: req.Description,
+		Address:     req.Address,
+	}
+	orgMsg, err := rpc.LoginServiceClient.CreateOrganization(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	org := &user.OrganizationList{}
+	copier.Copy(org, orgMsg)
+	c.JSON(http.StatusOK, result.Success(org))
+}
+
+// createOrgInvite 生成组织邀请码
+func (u *HandlerUser) createOrgInvite(c *gin.Context) {
+	result := &common.Result{}
+	var req user.OrgInviteReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	if req.OrganizationCode == "" {
+		req.OrganizationCode = c.GetString("organizationCode")
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.OrgInviteMessage{
+		MemId:            c.GetInt64("memberId"),
+		OrganizationCode: req.OrganizationCode,
+		ExpireHours:      req.ExpireHours,
+	}
+	inviteRsp, err := rpc.LoginServiceClient.CreateOrgInvite(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(&user.OrgInviteRsp{InviteCode: inviteRsp.InviteCode, ExpireTime: inviteRsp.ExpireTime}))
+}
+
+// acceptOrgInvite 使用邀请码加入组织
+func (u *HandlerUser) acceptOrgInvite(c *gin.Context) {
+	result := &common.Result{}
+	var req user.OrgInviteReq
+	if err := c.ShouldBind(&req); err != nil || req.InviteCode == "" {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.OrgInviteMessage{MemId: c.GetInt64("memberId"), InviteCode: req.InviteCode}
+	orgMsg, err := rpc.LoginServiceClient.AcceptOrgInvite(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	org := &user.OrganizationList{}
+	copier.Copy(org, orgMsg)
+	c.JSON(http.StatusOK, result.Success(org))
+}
+
+// removeOrgMember 移除组织成员，memberCode 为自己时表示退出组织
+func (u *HandlerUser) removeOrgMember(c *gin.Context) {
+	u.orgMember(c, rpc.LoginServiceClient.RemoveOrgMember)
+}
+
+// transferOrganization 将组织转让给组织中的另一个成员
+func (u *HandlerUser) transferOrganization(c *gin.Context) {
+	u.orgMember(c, rpc.LoginServiceClient.TransferOrganization)
+}
+
+// orgMember 处理针对组织成员的操作请求
+func (u *HandlerUser) orgMember(c *gin.Context, call func(context.Context, *login.OrgMemberMessage, ...grpc.CallOption) (*login.OrgMemberResponse, error)) {
+	result := &common.Result{}
+	var req user.OrgMemberReq
+	if err := c.ShouldBind(&req); err != nil || req.MemberCode == "" {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	if req.OrganizationCode == "" {
+		req.OrganizationCode = c.GetString("organizationCode")
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.OrgMemberMessage{
+		MemId:            c.GetInt64("memberId"),
+		OrganizationCode: req.OrganizationCode,
+		MemberCode:       req.MemberCode,
+	}
+	if _, err := call(ctx, msg); err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(""))
+}
+
+// updateProfile 修改个人资料
+func (u *HandlerUser) updateProfile(c *gin.Context) {
+	result := &common.Result{}
+	var req user.ProfileReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.ProfileMessage{}
+	copier.Copy(msg, req)
+	msg.MemId = c.GetInt64("memberId")
+	memMsg, err := rpc.LoginServiceClient.UpdateProfile(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	mem := &user.Member{}
+	copier.Copy(mem, memMsg)
+	c.JSON(http.StatusOK, result.Success(mem))
+}
+
+// changePassword 校验原密码后修改密码，修改成功后需要重新登录
+func (u *HandlerUser) changePassword(c *gin.Context) {
+	result := &common.Result{}
+	var req user.ChangePasswordReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	if err := req.Verify(); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, err.Error()))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.ChangePasswordMessage{
+		MemId:       c.GetInt64("memberId"),
+		OldPassword: req.OldPassword,
+		Password:    req.Password,
+		Ip:          GetIp(c),
+	}
+	if _, err := rpc.LoginServiceClient.ChangePassword(ctx, msg); err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(""))
+}
+
+// getChangeCode 向新的手机号或邮箱发送验证码
+func (u *HandlerUser) getChangeCode(c *gin.Context) {
+	result := &common.Result{}
+	var req user.ChangeContactReq
+	if err := c.ShouldBind(&req); err != nil || req.Target == "" {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.ChangeContactMessage{MemId: c.GetInt64("memberId"), Target: req.Target, Ip: GetIp(c)}
+	rsp, err := rpc.LoginServiceClient.SendChangeCode(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(rsp.Code))
+}
+
+// changeMobile 使用验证码修改手机号
+func (u *HandlerUser) changeMobile(c *gin.Context) {
+	u.changeContact(c, rpc.LoginServiceClient.ChangeMobile)
+}
+
+// changeEmail 使用验证码修改邮箱
+func (u *HandlerUser) changeEmail(c *gin.Context) {
+	u.changeContact(c, rpc.LoginServiceClient.ChangeEmail)
+}
+
+// changeContact 处理修改手机号或邮箱的请求
+func (u *HandlerUser) changeContact(c *gin.Context, call func(context.Context, *login.ChangeContactMessage, ...grpc.CallOption) (*login.MemberMessage, error)) {
+	result := &common.Result{}
+	var req user.ChangeContactReq
+	if err := c.ShouldBind(&req); err != nil || req.Target == "" || req.Captcha == "" {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.ChangeContactMessage{MemId: c.GetInt64("memberId"), Target: req.Target, Captcha: req.Captcha}
+	memMsg, err := call(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	mem := &user.Member{}
+	copier.Copy(mem, memMsg)
+	c.JSON(http.StatusOK, result.Success(mem))
+}
+
+// loginHistory 分页查询我的登录记录
+func (u *HandlerUser) loginHistory(c *gin.Context) {
+	result := &common.Result{}
+	var req user.LoginLogReq
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.LoginLogMessage{MemId: c.GetInt64("memberId"), Page: req.Page, PageSize: req.PageSize}
+	rsp, err := rpc.LoginServiceClient.LoginHistory(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	list := []*user.LoginLog{}
+	copier.Copy(&list, rsp.List)
+	c.JSON(http.StatusOK, result.Success(gin.H{
+		"list":  list,
+		"total": rsp.Total,
+		"page":  req.Page,
+	}))
+}
+
+// activeSessions 查询我的活跃会话，current 标记当前请求所在的会话
+func (u *HandlerUser) activeSessions(c *gin.Context) {
+	result := &common.Result{}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.LoginLogMessage{MemId: c.GetInt64("memberId"), Token: c.GetHeader("Authorization")}
+	rsp, err := rpc.LoginServiceClient.ActiveSessions(ctx, msg)
+	if err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	list := []*user.Session{}
+	copier.Copy(&list, rsp.List)
+	c.JSON(http.StatusOK, result.Success(list))
+}
+
+// revokeSession 吊销我的一个会话，例如在其他设备上的登录
+func (u *HandlerUser) revokeSession(c *gin.Context) {
+	result := &common.Result{}
+	var req user.LoginLogReq
+	if err := c.ShouldBind(&req); err != nil || req.SessionCode == "" {
+		c.JSON(http.StatusOK, result.Fail(http.StatusBadRequest, "参数格式有误"))
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+	msg := &login.LoginLogMessage{MemId: c.GetInt64("memberId"), SessionCode: req.SessionCode}
+	if _, err := rpc.LoginServiceClient.RevokeSession(ctx, msg); err != nil {
+		code, msg := errs.ParseGrpcError(err)
+		c.JSON(http.StatusOK, result.Fail(code, msg))
+		return
+	}
+	c.JSON(http.StatusOK, result.Success(""))
+}
+
+// splitScopes 拆分逗号分隔的授权范围
+func splitScopes(scopes string) []string {
+	var list []string
+	for _, s := range strings.Split(scopes, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
 // myOrgList 处理用户获取自己所在的组织列表的请求。
 // c *gin.Context: Gin框架的上下文对象，用于处理HTTP请求和响应。
 func (u *HandlerUser) myOrgList(c *gin.Context) {
@@ -188,7 +766,7 @@ func (u *HandlerUser) myOrgList(c *gin.Context) {
 	req := &login.UserMessage{MemId: memberId}
 
 	// 调用RPC服务，获取当前用户所在的组织列表。
-	list, err2 := rpc.LoginServiceClient.MyOrgList(context.Background(), req)
+	list, err2 := rpc.LoginServiceClient.MyOrgList(c.Request.Context(), req)
 	// 如果发生错误，解析gRPC错误并返回相应的错误响应。
 	if err2 != nil {
 		code, msg := errs.ParseGrpcError(err2)
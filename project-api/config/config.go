@@ -2,10 +2,14 @@ package config
 
 // 导入必要的包
 import (
+	"github.com/go-redis/redis/v8"
 	"github.com/spf13/viper"
 	"log"
 	"os"
+	"project-common/discovery"
 	"project-common/logs"
+	"project-common/tracing"
+	"time"
 )
 
 // C 是配置的全局实例
@@ -13,16 +17,23 @@ var C = InitConfig()
 
 // Config 是应用程序配置的结构体
 type Config struct {
-	viper      *viper.Viper
-	SC         *ServerConfig
-	GC         *GrpcConfig
-	EtcdConfig *EtcdConfig
+	viper       *viper.Viper
+	SC          *ServerConfig
+	GC          *GrpcConfig
+	EtcdConfig  *EtcdConfig
+	AuthConfig  *AuthConfig
+	RateLimit   *RateLimitConfig
+	Trace       *tracing.Config
+	Swagger     *SwaggerConfig
+	Idempotency *IdempotencyConfig
+	Canary      *CanaryConfig
 }
 
 // ServerConfig 服务器配置的结构体
 type ServerConfig struct {
-	Name string
-	Addr string
+	Name           string
+	Addr           string
+	TrustedProxies []string // 可信的反向代理地址或网段，只有来自这些地址的 X-Forwarded-For 才会被采信，为空时直接使用连接的对端地址
 }
 
 // GrpcConfig gRPC配置的结构体
@@ -31,6 +42,44 @@ type GrpcConfig struct {
 	Addr string
 }
 
+// AuthConfig 接口权限配置的结构体
+type AuthConfig struct {
+	OwnerUnrestricted bool // 组织的拥有者是否不受接口权限限制
+}
+
+// RateLimitConfig 接口限流配置的结构体
+type RateLimitConfig struct {
+	Enabled bool
+	Limit   int              // 未单独配置的接口在每个窗口内允许的请求数
+	Window  time.Duration    // 未单独配置的接口的统计窗口
+	Routes  []RateLimitRoute // 单独配置限额的接口
+}
+
+// RateLimitRoute 单个接口的限额，Path 以 /* 结尾时匹配该前缀下的所有接口
+type RateLimitRoute struct {
+	Path   string
+	Limit  int
+	Window time.Duration
+}
+
+// SwaggerConfig 接口文档配置的结构体
+type SwaggerConfig struct {
+	Enabled bool // 是否提供 OpenAPI 文档和 Swagger UI，生产环境应关闭
+}
+
+// IdempotencyConfig 幂等键配置的结构体
+type IdempotencyConfig struct {
+	Enabled bool
+	TTL     time.Duration // 首次响应的保存时间，超过后相同的幂等键按新请求处理
+}
+
+// CanaryConfig 灰度发布配置的结构体
+type CanaryConfig struct {
+	Header   string                       // 值为 true 时把请求转发到灰度版本的请求头
+	Members  []int64                      // 请求总是转发到灰度版本的成员
+	Services map[string]*discovery.Canary // 各服务的灰度版本和普通请求转发到灰度版本的百分比，key 为服务名
+}
+
 // EtcdConfig Etcd配置的结构体
 type EtcdConfig struct {
 	Addrs []string
@@ -56,6 +105,12 @@ func InitConfig() *Config {
 	conf.ReadServerConfig()
 	conf.InitZapLog()
 	conf.ReadEtcdConfig()
+	conf.ReadAuthConfig()
+	conf.ReadRateLimitConfig()
+	conf.ReadTraceConfig()
+	conf.ReadSwaggerConfig()
+	conf.ReadIdempotencyConfig()
+	conf.ReadCanaryConfig()
 	return conf
 }
 
@@ -65,6 +120,7 @@ func (c *Config) ReadServerConfig() {
 	// 从配置文件中获取服务器的名称和地址
 	sc.Name = c.viper.GetString("server.name")
 	sc.Addr = c.viper.GetString("server.addr")
+	sc.TrustedProxies = c.viper.GetStringSlice("server.trustedProxies")
 	c.SC = sc
 }
 
@@ -96,3 +152,84 @@ func (c *Config) ReadEtcdConfig() {
 	ec.Addrs = addrs
 	c.EtcdConfig = ec
 }
+
+// ReadAuthConfig 读取接口权限配置，默认组织的拥有者不受接口权限限制
+func (c *Config) ReadAuthConfig() {
+	c.viper.SetDefault("auth.ownerUnrestricted", true)
+	c.AuthConfig = &AuthConfig{
+		OwnerUnrestricted: c.viper.GetBool("auth.ownerUnrestricted"),
+	}
+}
+
+// ReadRateLimitConfig 读取接口限流配置，默认每个用户（未登录时每个IP）每分钟120次请求
+func (c *Config) ReadRateLimitConfig() {
+	c.viper.SetDefault("rateLimit.enabled", true)
+	c.viper.SetDefault("rateLimit.limit", 120)
+	c.viper.SetDefault("rateLimit.window", time.Minute)
+	rc := &RateLimitConfig{
+		Enabled: c.viper.GetBool("rateLimit.enabled"),
+		Limit:   c.viper.GetInt("rateLimit.limit"),
+		Window:  c.viper.GetDuration("rateLimit.window"),
+	}
+	err := c.viper.UnmarshalKey("rateLimit.routes", &rc.Routes)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	for i := range rc.Routes {
+		if rc.Routes[i].Window <= 0 {
+			rc.Routes[i].Window = rc.Window
+		}
+	}
+	c.RateLimit = rc
+}
+
+// ReadRedisConfig 读取Redis配置信息并返回redis.Options配置项
+func (c *Config) ReadRedisConfig() *redis.Options {
+	return &redis.Options{
+		Addr:     c.viper.GetString("redis.host") + ":" + c.viper.GetString("redis.port"),
+		Password: c.viper.GetString("redis.password"),
+		DB:       c.viper.GetInt("redis.db"),
+	}
+}
+
+// ReadTraceConfig 读取链路追踪配置，exporter 为空时只生成 traceId 不导出 span
+func (c *Config) ReadTraceConfig() {
+	c.viper.SetDefault("trace.sampleRatio", 1)
+	c.Trace = &tracing.Config{
+		Exporter:    c.viper.GetString("trace.exporter"),
+		Endpoint:    c.viper.GetString("trace.endpoint"),
+		SampleRatio: c.viper.GetFloat64("trace.sampleRatio"),
+	}
+}
+
+// ReadSwaggerConfig 读取接口文档配置，默认不提供接口文档
+func (c *Config) ReadSwaggerConfig() {
+	c.Swagger = &SwaggerConfig{
+		Enabled: c.viper.GetBool("swagger.enabled"),
+	}
+}
+
+// ReadIdempotencyConfig 读取幂等键配置，默认开启，首次响应保存24小时
+func (c *Config) ReadIdempotencyConfig() {
+	c.viper.SetDefault("idempotency.enabled", true)
+	c.viper.SetDefault("idempotency.ttl", 24*time.Hour)
+	c.Idempotency = &IdempotencyConfig{
+		Enabled: c.viper.GetBool("idempotency.enabled"),
+		TTL:     c.viper.GetDuration("idempotency.ttl"),
+	}
+}
+
+// ReadCanaryConfig 读取灰度发布配置，默认使用 X-Canary 请求头，没有配置服务时不灰度
+func (c *Config) ReadCanaryConfig() {
+	c.viper.SetDefault("canary.header", "X-Canary")
+	cc := &CanaryConfig{
+		Header: c.viper.GetString("canary.header"),
+	}
+	if err := c.viper.UnmarshalKey("canary.members", &cc.Members); err != nil {
+		log.Fatalln(err)
+	}
+	if err := c.viper.UnmarshalKey("canary.services", &cc.Services); err != nil {
+		log.Fatalln(err)
+	}
+	c.Canary = cc
+}
@@ -4,22 +4,38 @@ import (
 	"fmt"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
+	"log"
 	"net/http"
 	_ "project-api/api"
 	"project-api/api/midd"
 	"project-api/config"
 	"project-api/router"
 	srv "project-common"
+	"project-common/metrics"
+	"project-common/tracing"
 	"time"
 )
 
 func main() {
 	r := gin.Default()
+	// 只采信可信代理转发的 X-Forwarded-For，否则客户端可以伪造 IP 绕过限流
+	if err := r.SetTrustedProxies(trustedProxies(config.C.SC.TrustedProxies)); err != nil {
+		log.Fatalln(err)
+	}
+	// 初始化链路追踪
+	stopTrace, err := tracing.Init(config.C.SC.Name, config.C.Trace)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	r.Use(midd.Trace())
 	r.Use(midd.RequestLog())
+	r.Use(midd.Metrics())
 	// 静态文件
 	r.StaticFS("/upload", http.Dir("upload"))
 	//路由
 	router.InitRouter(r)
+	// Prometheus 指标
+	r.GET("/metrics", metrics.Handler())
 	//开启pprof 默认的访问路径是/debug/pprof
 	pprof.Register(r)
 	//测试代码
@@ -35,7 +51,15 @@ func main() {
 			alloc1(outCh) // 不停的有goruntine因为outCh堵塞，无法释放
 		}
 	})
-	srv.Run(r, config.C.SC.Name, config.C.SC.Addr, nil)
+	srv.Run(r, config.C.SC.Name, config.C.SC.Addr, stopTrace)
+}
+
+// trustedProxies 没有配置代理时返回 nil，gin 将不信任任何转发头
+func trustedProxies(proxies []string) []string {
+	if len(proxies) == 0 {
+		return nil
+	}
+	return proxies
 }
 
 // 一个外层函数
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"project-api/api/swagger"
+	"project-api/pkg/openapi"
+	"project-api/router"
+	"project-common/logs"
+	"strings"
+	"testing"
+)
+
+// TestOpenApiCoversRoutes 网关注册的每个路由都必须写入接口文档，文档中也不能有未注册的接口
+func TestOpenApiCoversRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	// 初始化路由时连接不到用户服务会记录错误日志，测试中不写日志文件
+	logs.LG = zap.NewNop()
+	zap.ReplaceGlobals(logs.LG)
+	r := gin.New()
+	router.InitRouter(r)
+	doc := swagger.Doc()
+	registered := map[string]bool{}
+	for _, ri := range r.Routes() {
+		if swagger.Ignored(ri.Path) {
+			continue
+		}
+		registered[ri.Method+" "+openapi.Path(ri.Path)] = true
+		if !doc.Has(ri.Method, ri.Path) {
+			t.Errorf("route %s %s is missing from the OpenAPI spec", ri.Method, ri.Path)
+		}
+	}
+	for path, item := range doc.Paths {
+		for method := range *item {
+			if !registered[strings.ToUpper(method)+" "+path] {
+				t.Errorf("route %s %s in the OpenAPI spec is not registered", strings.ToUpper(method), path)
+			}
+		}
+	}
+}
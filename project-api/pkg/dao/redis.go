@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"math/rand"
+	"project-api/config"
+	"strconv"
+	"time"
+)
+
+var Rc *RedisCache
+
+type RedisCache struct {
+	rdb *redis.Client
+}
+
+func init() {
+	rdb := redis.NewClient(config.C.ReadRedisConfig())
+	Rc = &RedisCache{
+		rdb: rdb,
+	}
+}
+
+// Get 获取redis
+func (rc *RedisCache) Get(ctx context.Context, key string) (string, error) {
+	return rc.rdb.Get(ctx, key).Result()
+}
+
+// Put 存入redis
+func (rc *RedisCache) Put(ctx context.Context, key, value string, expire time.Duration) error {
+	return rc.rdb.Set(ctx, key, value, expire).Err()
+}
+
+// PutNX key不存在时存入redis，返回是否存入
+func (rc *RedisCache) PutNX(ctx context.Context, key, value string, expire time.Duration) (bool, error) {
+	return rc.rdb.SetNX(ctx, key, value, expire).Result()
+}
+
+// Del 删除redis
+func (rc *RedisCache) Del(ctx context.Context, keys ...string) error {
+	return rc.rdb.Del(ctx, keys...).Err()
+}
+
+// Subscribe 订阅redis频道，断线后会自动重新订阅
+func (rc *RedisCache) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	return rc.rdb.Subscribe(ctx, channels...)
+}
+
+// slidingWindowScript 滑动窗口限流脚本，窗口内的每次请求以毫秒时间戳为分数保存在有序集合中。
+// 返回 {是否允许, 窗口内剩余次数, 最早的请求离开窗口的毫秒数}
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], 0, now - window)
+local count = redis.call('ZCARD', KEYS[1])
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[4])
+	redis.call('PEXPIRE', KEYS[1], window)
+	count = count + 1
+	allowed = 1
+end
+local reset = window
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, limit - count, reset}
+`)
+
+// SlidingWindow 在滑动窗口内记录一次请求，返回是否允许、窗口内剩余次数和窗口重置的时间
+func (rc *RedisCache) SlidingWindow(ctx context.Context, key string, limit int, window time.Duration) (bool, int64, time.Duration, error) {
+	now := time.Now()
+	member := strconv.FormatInt(now.UnixNano(), 10) + "-" + strconv.FormatUint(rand.Uint64(), 36)
+	val, err := slidingWindowScript.Run(ctx, rc.rdb, []string{key}, now.UnixMilli(), window.Milliseconds(), limit, member).Result()
+	if err != nil {
+		return true, 0, 0, err
+	}
+	res, ok := val.([]interface{})
+	if !ok || len(res) != 3 {
+		return true, 0, 0, fmt.Errorf("unexpected sliding window result: %v", val)
+	}
+	allowed, _ := res[0].(int64)
+	remaining, _ := res[1].(int64)
+	reset, _ := res[2].(int64)
+	return allowed == 1, remaining, time.Duration(reset) * time.Millisecond, nil
+}
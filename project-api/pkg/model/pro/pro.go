@@ -122,3 +122,30 @@ type MemberProjectResp struct {
 	Code    string `json:"code"`
 	IsOwner int    `json:"isOwner"`
 }
+
+// ProjectMemberRoleReq 修改项目成员角色的请求
+type ProjectMemberRoleReq struct {
+	ProjectCode string `json:"projectCode" form:"projectCode"` // 项目的代码
+	MemberCode  string `json:"memberCode" form:"memberCode"`   // 被修改的成员代码
+	Role        string `json:"role" form:"role"`               // 新的角色：admin、member、viewer
+}
+
+// ProjectPatchReq /api/v2 修改项目的请求，只修改请求中出现的字段
+type ProjectPatchReq struct {
+	Cover              *string  `json:"cover"`              // 项目的封面图片URL
+	Name               *string  `json:"name"`               // 项目的名称
+	Description        *string  `json:"description"`        // 项目的描述
+	Schedule           *float64 `json:"schedule"`           // 项目的进度
+	Private            *int     `json:"private"`            // 项目是否私有
+	Prefix             *string  `json:"prefix"`             // 项目的前缀
+	OpenPrefix         *int     `json:"openPrefix"`         // 是否开放前缀
+	OpenBeginTime      *int     `json:"openBeginTime"`      // 开放开始时间
+	OpenTaskPrivate    *int     `json:"openTaskPrivate"`    // 开放任务是否私有
+	TaskBoardTheme     *string  `json:"taskBoardTheme"`     // 任务看板的主题
+	AutoUpdateSchedule *int     `json:"autoUpdateSchedule"` // 是否自动更新进度
+}
+
+// ProjectMemberPatchReq /api/v2 修改项目成员角色的请求
+type ProjectMemberPatchReq struct {
+	Role string `json:"role"` // 新的角色：admin、member、viewer
+}
@@ -136,3 +136,46 @@ type ProjectInfo struct {
 	Name string `json:"name"`
 	Code string `json:"code"`
 }
+
+// TaskCreateReq /api/v2 在任务阶段下创建任务的请求
+type TaskCreateReq struct {
+	Name     string `json:"name"`
+	AssignTo string `json:"assignTo"`
+}
+
+// TaskPatchReq /api/v2 修改任务的请求，只修改请求中出现的字段
+type TaskPatchReq struct {
+	Name *string `json:"name"`
+}
+
+// TaskMoveReq /api/v2 移动任务的请求，移动到 toStageCode 阶段的 nextTaskCode 任务之前，nextTaskCode 为空时移动到末尾
+type TaskMoveReq struct {
+	ToStageCode  string `json:"toStageCode"`
+	NextTaskCode string `json:"nextTaskCode"`
+}
+
+// CommentCreateReq /api/v2 评论任务的请求
+type CommentCreateReq struct {
+	Comment  string   `json:"comment"`
+	Mentions []string `json:"mentions"`
+}
+
+// WorkTimeCreateReq /api/v2 登记任务工时的请求
+type WorkTimeCreateReq struct {
+	Content   string `json:"content"`
+	Num       int    `json:"num"`
+	BeginTime string `json:"beginTime"`
+}
+
+// BoardEvent 看板变化事件，由项目服务发布，type 为 task.created、task.updated 或 task.moved。
+// 事件里只有编号，客户端收到后按编号重新查询任务或阶段的任务列表
+type BoardEvent struct {
+	Type          string `json:"type"`
+	ProjectCode   string `json:"projectCode"`
+	StageCode     string `json:"stageCode"`
+	FromStageCode string `json:"fromStageCode,omitempty"`
+	TaskCode      string `json:"taskCode"`
+	NextTaskCode  string `json:"nextTaskCode,omitempty"`
+	MemberCode    string `json:"memberCode"`
+	Time          int64  `json:"time"`
+}
@@ -40,11 +40,194 @@ type LoginReq struct {
 	Password string `json:"password" form:"password"`
 }
 
+// RefreshTokenReq 刷新令牌请求结构体
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refreshToken" form:"refreshToken"`
+}
+
+// LogoutReq 退出登录请求结构体
+type LogoutReq struct {
+	All bool `json:"all" form:"all"`
+}
+
+// ResetCodeReq 获取找回密码验证码请求结构体
+type ResetCodeReq struct {
+	Account string `json:"account" form:"account"`
+}
+
+// ResetPasswordReq 重置密码请求结构体
+type ResetPasswordReq struct {
+	Account   string `json:"account" form:"account"`
+	Captcha   string `json:"captcha" form:"captcha"`
+	Password  string `json:"password" form:"password"`
+	Password2 string `json:"password2" form:"password2"`
+}
+
+// Verify 验证重置密码信息的合法性
+func (r ResetPasswordReq) Verify() error {
+	if !common.VerifyMobile(r.Account) && !common.VerifyEmailFormat(r.Account) {
+		return errors.New("手机号或邮箱格式不正确")
+	}
+	if r.Captcha == "" {
+		return errors.New("验证码不能为空")
+	}
+	if r.Password == "" || r.Password != r.Password2 {
+		return errors.New("两次密码输入不一致")
+	}
+	return nil
+}
+
+// ProfileReq 修改个人资料请求结构体
+type ProfileReq struct {
+	Name        string `json:"name" form:"name"`
+	Realname    string `json:"realname" form:"realname"`
+	Sex         int32  `json:"sex" form:"sex"`
+	Avatar      string `json:"avatar" form:"avatar"`
+	Province    int32  `json:"province" form:"province"`
+	City        int32  `json:"city" form:"city"`
+	Area        int32  `json:"area" form:"area"`
+	Address     string `json:"address" form:"address"`
+	Description string `json:"description" form:"description"`
+	Idcard      string `json:"idcard" form:"idcard"`
+}
+
+// ChangePasswordReq 修改密码请求结构体
+type ChangePasswordReq struct {
+	OldPassword string `json:"oldPassword" form:"oldPassword"`
+	Password    string `json:"password" form:"password"`
+	Password2   string `json:"password2" form:"password2"`
+}
+
+// Verify 验证修改密码信息的合法性
+func (r ChangePasswordReq) Verify() error {
+	if r.OldPassword == "" {
+		return errors.New("原密码不能为空")
+	}
+	if r.Password == "" || r.Password != r.Password2 {
+		return errors.New("两次密码输入不一致")
+	}
+	return nil
+}
+
+// ChangeContactReq 修改手机号或邮箱请求结构体，target 为新的手机号或邮箱
+type ChangeContactReq struct {
+	Target  string `json:"target" form:"target"`
+	Captcha string `json:"captcha" form:"captcha"`
+}
+
+// LoginLogReq 登录记录和会话请求结构体
+type LoginLogReq struct {
+	Page        int64  `json:"page" form:"page"`
+	PageSize    int64  `json:"pageSize" form:"pageSize"`
+	SessionCode string `json:"sessionCode" form:"sessionCode"`
+}
+
+// LoginLog 登录记录结构体
+type LoginLog struct {
+	Ip         string `json:"ip"`
+	UserAgent  string `json:"userAgent"`
+	Method     string `json:"method"`
+	Success    bool   `json:"success"`
+	Reason     string `json:"reason"`
+	CreateTime string `json:"createTime"`
+}
+
+// Session 活跃会话结构体
+type Session struct {
+	Code       string `json:"code"`
+	Ip         string `json:"ip"`
+	UserAgent  string `json:"userAgent"`
+	Method     string `json:"method"`
+	CreateTime string `json:"createTime"`
+	Current    bool   `json:"current"`
+}
+
+// LoginMfaReq 两步验证登录请求结构体
+type LoginMfaReq struct {
+	Ticket string `json:"ticket" form:"ticket"`
+	Code   string `json:"code" form:"code"`
+}
+
+// MfaReq 两步验证管理请求结构体
+type MfaReq struct {
+	Code string `json:"code" form:"code"`
+}
+
+// MfaEnrollRsp 开始开启两步验证响应结构体
+type MfaEnrollRsp struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+}
+
+// AccessTokenReq 个人访问令牌请求结构体，scopes 多个用逗号分隔
+type AccessTokenReq struct {
+	Name       string `json:"name" form:"name"`
+	Scopes     string `json:"scopes" form:"scopes"`
+	ExpireDays int32  `json:"expireDays" form:"expireDays"`
+	Code       string `json:"code" form:"code"`
+}
+
+// AccessToken 个人访问令牌结构体，不包含令牌明文
+type AccessToken struct {
+	Code         string   `json:"code"`
+	Name         string   `json:"name"`
+	TokenPrefix  string   `json:"tokenPrefix"`
+	Scopes       []string `json:"scopes"`
+	ExpireTime   string   `json:"expireTime"`
+	CreateTime   string   `json:"createTime"`
+	LastUsedTime string   `json:"lastUsedTime"`
+	LastUsedIp   string   `json:"lastUsedIp"`
+}
+
+// AccessTokenRsp 创建个人访问令牌响应结构体，token 只返回这一次
+type AccessTokenRsp struct {
+	Token string       `json:"token"`
+	Info  *AccessToken `json:"info"`
+}
+
+// SwitchOrganizationReq 切换组织请求结构体
+type SwitchOrganizationReq struct {
+	OrganizationCode string `json:"organizationCode" form:"organizationCode"`
+}
+
+// CreateOrganizationReq 创建团队组织请求结构体
+type CreateOrganizationReq struct {
+	Name        string `json:"name" form:"name"`
+	Avatar      string `json:"avatar" form:"avatar"`
+	Description string `json:"description" form:"description"`
+	Address     string `json:"address" form:"address"`
+}
+
+// OrgInviteReq 组织邀请请求结构体
+type OrgInviteReq struct {
+	OrganizationCode string `json:"organizationCode" form:"organizationCode"`
+	ExpireHours      int64  `json:"expireHours" form:"expireHours"`
+	InviteCode       string `json:"inviteCode" form:"inviteCode"`
+}
+
+// OrgInviteRsp 组织邀请响应结构体
+type OrgInviteRsp struct {
+	InviteCode string `json:"inviteCode"`
+	ExpireTime int64  `json:"expireTime"`
+}
+
+// OrgMemberReq 移除组织成员、转让组织请求结构体
+type OrgMemberReq struct {
+	OrganizationCode string `json:"organizationCode" form:"organizationCode"`
+	MemberCode       string `json:"memberCode" form:"memberCode"`
+}
+
+// UnlockMemberReq 解除账号锁定请求结构体
+type UnlockMemberReq struct {
+	Account string `json:"account" form:"account"`
+}
+
 // LoginRsp 登录响应结构体
 type LoginRsp struct {
 	Member           Member             `json:"member"`
 	TokenList        TokenList          `json:"tokenList"`
 	OrganizationList []OrganizationList `json:"organizationList"`
+	MfaTicket        string             `json:"mfaTicket"`
 }
 
 // Member 会员信息结构体
@@ -57,6 +240,9 @@ type Member struct {
 	CreateTime       string `json:"create_time"`
 	LastLoginTime    string `json:"last_login_time"`
 	OrganizationCode string `json:"organization_code"`
+	Avatar           string `json:"avatar"`
+	Sex              int32  `json:"sex"`
+	Description      string `json:"description"`
 }
 
 // TokenList 令牌列表结构体
@@ -0,0 +1,427 @@
+// Package openapi 根据网关的接口定义和请求、响应结构体生成 OpenAPI 3 文档。
+// 请求参数按 form 标签生成表单字段，带有 json 标签的结构体同时生成 JSON 请求体；
+// 响应统一包装为 common.Result，data 按 json 标签生成，具名结构体放在 components.schemas 中。
+// REST 接口（/api/v2）的请求体只支持 JSON，响应不包装，路径参数 :name 转换为 {name}。
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 请求体的类型
+const (
+	mimeForm      = "application/x-www-form-urlencoded"
+	mimeMultipart = "multipart/form-data"
+	mimeJSON      = "application/json"
+	mimeSSE       = "text/event-stream"
+)
+
+// bearerAuth 登录后接口使用的安全方案名称
+const bearerAuth = "bearerAuth"
+
+// idempotencyHeader 登录后的写接口支持的幂等键请求头
+const idempotencyHeader = "Idempotency-Key"
+
+// Route 单个接口的文档定义
+type Route struct {
+	Method   string // 请求方法，如 POST
+	Path     string // 与 gin 注册的路径一致
+	Tag      string // 接口分组
+	Summary  string // 接口说明
+	Auth     bool   // 是否需要登录
+	Raw      bool   // 响应不使用 common.Result 包装
+	JSON     bool   // 请求体只支持 JSON
+	Stream   bool   // 响应为 Server-Sent Events，Response 为单个事件的数据
+	Status   int    // 成功时的 HTTP 状态码，默认 200，204 时没有响应体
+	Query    any    // 查询参数：结构体或 gin.H{字段名: 示例值}，按 form 标签生成
+	Request  any    // 请求参数：结构体或 gin.H{字段名: 示例值}，为空时没有请求参数
+	Response any    // 响应的 data：结构体、切片、gin.H{字段名: 示例值}，为空时没有 data
+}
+
+// File 上传的文件，请求参数中出现时请求体使用 multipart/form-data
+type File struct{}
+
+// Info 文档的基本信息
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Tag 接口分组
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// Document OpenAPI 3 文档
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Tags       []Tag                `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// PathItem 同一路径下不同请求方法的接口，键为小写的请求方法
+type PathItem map[string]*Operation
+
+// Operation 单个接口
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	OperationId string                `json:"operationId"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter 路径参数或查询参数
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Required    bool    `json:"required,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody 请求体
+type RequestBody struct {
+	Content map[string]*MediaType `json:"content"`
+}
+
+// Response 响应
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType 请求体或响应的内容
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema 数据结构
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// SecurityScheme 安全方案
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// Components 可复用的数据结构和安全方案
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+// Build 根据接口定义生成文档
+func Build(info Info, routes []Route) *Document {
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info:    info,
+		Paths:   map[string]*PathItem{},
+		Components: Components{
+			Schemas: map[string]*Schema{},
+			SecuritySchemes: map[string]*SecurityScheme{
+				bearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+	tags := map[string]bool{}
+	for _, r := range routes {
+		if r.Tag != "" && !tags[r.Tag] {
+			tags[r.Tag] = true
+			doc.Tags = append(doc.Tags, Tag{Name: r.Tag})
+		}
+		item := doc.Paths[Path(r.Path)]
+		if item == nil {
+			item = &PathItem{}
+			doc.Paths[Path(r.Path)] = item
+		}
+		(*item)[strings.ToLower(r.Method)] = doc.operation(r)
+	}
+	return doc
+}
+
+// Has 文档中是否有该接口，path 为 gin 注册的路径
+func (d *Document) Has(method, path string) bool {
+	item := d.Paths[Path(path)]
+	return item != nil && (*item)[strings.ToLower(method)] != nil
+}
+
+// operation 生成单个接口的文档
+func (d *Document) operation(r Route) *Operation {
+	op := &Operation{
+		Summary:     r.Summary,
+		OperationId: strings.ToLower(r.Method) + strings.NewReplacer("/", "_", ".", "_", "-", "_").Replace(r.Path),
+		Responses:   map[string]*Response{},
+	}
+	if r.Tag != "" {
+		op.Tags = []string{r.Tag}
+	}
+	if r.Auth {
+		op.Security = []map[string][]string{{bearerAuth: {}}}
+		if r.Method != http.MethodGet {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:        idempotencyHeader,
+				In:          "header",
+				Description: "重试时携带相同的幂等键，返回首次请求的响应",
+				Schema:      &Schema{Type: "string"},
+			})
+		}
+	}
+	_, names := pathParams(r.Path)
+	for _, name := range names {
+		op.Parameters = append(op.Parameters, &Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+	if r.Query != nil {
+		query, _, _ := d.fields(reflect.TypeOf(r.Query), reflect.ValueOf(r.Query), "form")
+		for _, name := range sortedNames(query.Properties) {
+			op.Parameters = append(op.Parameters, &Parameter{Name: name, In: "query", Schema: query.Properties[name]})
+		}
+	}
+	if r.Request != nil {
+		op.RequestBody = d.requestBody(r.Request, r.JSON)
+	}
+	status := r.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	if status == http.StatusNoContent {
+		op.Responses[strconv.Itoa(status)] = &Response{Description: http.StatusText(status)}
+		return op
+	}
+	var data *Schema
+	if r.Response != nil {
+		data = d.schema(reflect.TypeOf(r.Response), reflect.ValueOf(r.Response))
+	}
+	if r.Stream {
+		op.Responses[strconv.Itoa(status)] = &Response{
+			Description: http.StatusText(status),
+			Content:     map[string]*MediaType{mimeSSE: {Schema: data}},
+		}
+		return op
+	}
+	if r.Raw {
+		if data == nil {
+			data = &Schema{Type: "object"}
+		}
+	} else {
+		data = result(data)
+	}
+	op.Responses[strconv.Itoa(status)] = &Response{
+		Description: http.StatusText(status),
+		Content:     map[string]*MediaType{mimeJSON: {Schema: data}},
+	}
+	return op
+}
+
+// result 将 data 包装为 common.Result
+func result(data *Schema) *Schema {
+	if data == nil {
+		data = &Schema{}
+	}
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"code": {Type: "integer", Format: "int32", Description: "业务状态码，200 表示成功"},
+			"msg":  {Type: "string", Description: "成功或失败的原因"},
+			"data": data,
+		},
+	}
+}
+
+// requestBody 生成请求体，表单字段按 form 标签；所有字段都有 json 标签时同时支持 JSON 请求体，onlyJSON 时只支持 JSON
+func (d *Document) requestBody(req any, onlyJSON bool) *RequestBody {
+	body := &RequestBody{Content: map[string]*MediaType{}}
+	if onlyJSON {
+		body.Content[mimeJSON] = &MediaType{Schema: d.schema(reflect.TypeOf(req), reflect.ValueOf(req))}
+		return body
+	}
+	form, multipart, allJSON := d.fields(reflect.TypeOf(req), reflect.ValueOf(req), "form")
+	if multipart {
+		body.Content[mimeMultipart] = &MediaType{Schema: form}
+		return body
+	}
+	body.Content[mimeForm] = &MediaType{Schema: form}
+	if allJSON {
+		body.Content[mimeJSON] = &MediaType{Schema: d.schema(reflect.TypeOf(req), reflect.ValueOf(req))}
+	}
+	return body
+}
+
+// fields 按指定的标签生成结构体或 gin.H 的字段，返回是否有上传文件和是否所有字段都有 json 标签
+func (d *Document) fields(t reflect.Type, v reflect.Value, tag string) (*Schema, bool, bool) {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	multipart, allJSON := false, false
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Map {
+		for _, k := range sortedKeys(v) {
+			ev := v.MapIndex(k).Elem()
+			if ev.Type() == fileType {
+				multipart = true
+			}
+			s.Properties[k.String()] = d.schema(ev.Type(), ev)
+		}
+		return s, multipart, false
+	}
+	fields := structFields(t, tag)
+	allJSON = len(fields) > 0
+	for _, f := range fields {
+		if f.typ == fileType {
+			multipart = true
+		}
+		if !f.json {
+			allJSON = false
+		}
+		s.Properties[f.name] = d.schema(f.typ, reflect.Value{})
+	}
+	return s, multipart, allJSON
+}
+
+var fileType = reflect.TypeOf(File{})
+
+// schema 生成类型的数据结构，具名结构体生成引用；v 有效时用于读取 gin.H 的字段
+func (d *Document) schema(t reflect.Type, v reflect.Value) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		if v.IsValid() {
+			v = v.Elem()
+		}
+	}
+	if t == fileType {
+		return &Schema{Type: "string", Format: "binary"}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: d.schema(t.Elem(), reflect.Value{})}
+	case reflect.Map:
+		if v.IsValid() && t.Elem().Kind() == reflect.Interface {
+			s, _, _ := d.fields(t, v, "json")
+			return s
+		}
+		return &Schema{Type: "object", AdditionalProperties: d.schema(t.Elem(), reflect.Value{})}
+	case reflect.Struct:
+		if t.Name() == "" {
+			s, _, _ := d.fields(t, v, "json")
+			return s
+		}
+		name := schemaName(t)
+		if _, ok := d.Components.Schemas[name]; !ok {
+			// 先占位，结构体引用自身时（如菜单的 children）不会无限递归
+			s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+			d.Components.Schemas[name] = s
+			for _, f := range structFields(t, "json") {
+				s.Properties[f.name] = d.schema(f.typ, reflect.Value{})
+			}
+		}
+		return &Schema{Ref: "#/components/schemas/" + name}
+	}
+	return &Schema{}
+}
+
+// field 结构体字段
+type field struct {
+	name string
+	typ  reflect.Type
+	json bool
+}
+
+// structFields 按指定的标签返回结构体的字段，嵌入的结构体展开，标签为 - 的字段忽略，没有标签时使用字段名
+func structFields(t reflect.Type, tag string) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			fields = append(fields, structFields(f.Type, tag)...)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		_, hasJSON := f.Tag.Lookup("json")
+		fields = append(fields, field{name: name, typ: f.Type, json: hasJSON})
+	}
+	return fields
+}
+
+// schemaName 结构体在 components.schemas 中的名称，如 pro.Project
+func schemaName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	return pkg + "." + t.Name()
+}
+
+// sortedKeys 按字母顺序返回 gin.H 的键，保证生成的文档稳定
+func sortedKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	return keys
+}
+
+// sortedNames 按字母顺序返回字段名，保证生成的文档稳定
+func sortedNames(properties map[string]*Schema) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Path 将 gin 注册的路径转换为文档中的路径，如 /api/v2/projects/:projectCode 转换为 /api/v2/projects/{projectCode}
+func Path(path string) string {
+	p, _ := pathParams(path)
+	return p
+}
+
+// pathParams 将 gin 的路径参数 :name 转换为 {name}，返回转换后的路径和参数名
+func pathParams(path string) (string, []string) {
+	var names []string
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if name, ok := strings.CutPrefix(seg, ":"); ok {
+			names = append(names, name)
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/"), names
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	projectApi "project-api/api/project"
+	"project-grpc/project"
+	"strings"
+	"testing"
+)
+
+// fakeProjectClient 按固定的数据解析项目参数，出现的参数属于不同的项目时按不是项目成员处理，与项目服务一致
+type fakeProjectClient struct {
+	project.ProjectServiceClient
+	tasks  map[string]string // 任务所属的项目
+	stages map[string]string // 任务阶段所属的项目
+	roles  map[string]string // 成员在项目中的角色
+	sent   []*project.ProjectRpcMessage
+}
+
+func (f *fakeProjectClient) FindProjectByMemberId(_ context.Context, in *project.ProjectRpcMessage, _ ...grpc.CallOption) (*project.FindProjectByMemberIdResponse, error) {
+	f.sent = append(f.sent, in)
+	var resolved []string
+	if in.ProjectCode != "" {
+		resolved = append(resolved, in.ProjectCode)
+	}
+	if in.TaskCode != "" {
+		resolved = append(resolved, f.tasks[in.TaskCode])
+	}
+	if in.StageCode != "" {
+		resolved = append(resolved, f.stages[in.StageCode])
+	}
+	for _, pc := range resolved {
+		if pc == "" || pc != resolved[0] {
+			return &project.FindProjectByMemberIdResponse{}, nil
+		}
+	}
+	role := f.roles[resolved[0]]
+	if role == "" {
+		return &project.FindProjectByMemberIdResponse{}, nil
+	}
+	return &project.FindProjectByMemberIdResponse{
+		Project:  &project.ProjectMessage{Code: resolved[0]},
+		IsMember: true,
+		Role:     role,
+	}, nil
+}
+
+// TestProjectAuthScope 项目权限只按处理函数使用的参数确定项目，混入其他项目的参数不能绕过校验
+func TestProjectAuthScope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	fake := &fakeProjectClient{
+		tasks:  map[string]string{"t1": "p1", "t2": "p2", "t3": "p3"},
+		stages: map[string]string{"s1": "p1", "s2": "p2"},
+		roles:  map[string]string{"p1": "member", "p3": "viewer"},
+	}
+	old := projectApi.ProjectServiceClient
+	projectApi.ProjectServiceClient = fake
+	defer func() { projectApi.ProjectServiceClient = old }()
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) { c.Set("memberId", int64(1)) }, projectApi.ProjectAuth())
+	for _, path := range []string{"/project/task/read", "/project/task/edit", "/project/task/save",
+		"/project/task_stages/tasks", "/project/file/uploadFiles", "/project/task/selfList"} {
+		r.POST(path, func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"code": http.StatusOK}) })
+	}
+
+	tests := []struct {
+		name string
+		path string
+		form url.Values
+		want int
+		sent *project.ProjectRpcMessage // 发给项目服务的参数，nil 表示不调用项目服务
+	}{
+		{"own task", "/project/task/read", url.Values{"taskCode": {"t1"}}, http.StatusOK,
+			&project.ProjectRpcMessage{TaskCode: "t1"}},
+		{"own project with foreign task", "/project/task/read", url.Values{"projectCode": {"p1"}, "taskCode": {"t2"}}, http.StatusForbidden,
+			&project.ProjectRpcMessage{TaskCode: "t2"}},
+		{"edit foreign task with own project", "/project/task/edit", url.Values{"projectCode": {"p1"}, "taskCode": {"t2"}}, http.StatusForbidden,
+			&project.ProjectRpcMessage{TaskCode: "t2"}},
+		{"viewer cannot edit", "/project/task/edit", url.Values{"taskCode": {"t3"}}, http.StatusForbidden,
+			&project.ProjectRpcMessage{TaskCode: "t3"}},
+		{"own task through preTaskCode only", "/project/task/edit", url.Values{"preTaskCode": {"t1"}}, http.StatusForbidden, nil},
+		{"save into own stage", "/project/task/save", url.Values{"project_code": {"p1"}, "stage_code": {"s1"}}, http.StatusOK,
+			&project.ProjectRpcMessage{ProjectCode: "p1", StageCode: "s1"}},
+		{"save into foreign stage", "/project/task/save", url.Values{"project_code": {"p1"}, "stage_code": {"s2"}}, http.StatusForbidden,
+			&project.ProjectRpcMessage{ProjectCode: "p1", StageCode: "s2"}},
+		{"save without stage", "/project/task/save", url.Values{"project_code": {"p1"}}, http.StatusForbidden, nil},
+		{"foreign stage with own project", "/project/task_stages/tasks", url.Values{"projectCode": {"p1"}, "stageCode": {"s2"}}, http.StatusForbidden,
+			&project.ProjectRpcMessage{StageCode: "s2"}},
+		{"upload to foreign task", "/project/file/uploadFiles", url.Values{"projectCode": {"p1"}, "taskCode": {"t2"}}, http.StatusForbidden,
+			&project.ProjectRpcMessage{ProjectCode: "p1", TaskCode: "t2"}},
+		{"unscoped route", "/project/task/selfList", url.Values{}, http.StatusOK, nil},
+		{"unscoped route with foreign project", "/project/task/selfList", url.Values{"projectCode": {"p2"}}, http.StatusForbidden,
+			&project.ProjectRpcMessage{ProjectCode: "p2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.sent = nil
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			var result struct{ Code int }
+			if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+				t.Fatalf("decode response %q: %v", w.Body.String(), err)
+			}
+			if result.Code != tt.want {
+				t.Errorf("code = %d, want %d", result.Code, tt.want)
+			}
+			if tt.sent == nil {
+				if len(fake.sent) != 0 {
+					t.Errorf("project service called with %v, want no call", fake.sent)
+				}
+				return
+			}
+			if len(fake.sent) != 1 {
+				t.Fatalf("project service called %d times, want 1", len(fake.sent))
+			}
+			got := fake.sent[0]
+			if got.ProjectCode != tt.sent.ProjectCode || got.TaskCode != tt.sent.TaskCode || got.StageCode != tt.sent.StageCode {
+				t.Errorf("sent project=%q task=%q stage=%q, want project=%q task=%q stage=%q",
+					got.ProjectCode, got.TaskCode, got.StageCode, tt.sent.ProjectCode, tt.sent.TaskCode, tt.sent.StageCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/serviceconfig"
+	"math/rand"
+	"sync"
+)
+
+// WeightedRoundRobin 按服务注册的权重（Server.Weight）平滑加权轮询的负载均衡策略，
+// 配置了灰度版本时，灰度请求和按比例抽取的请求只转发到该版本（Server.Version）的节点
+const WeightedRoundRobin = "smooth_weighted_round_robin"
+
+// CanaryKey 灰度请求携带的 gRPC metadata，值为 true 时转发到灰度版本
+const CanaryKey = "x-canary"
+
+// 地址属性中保存权重和版本的 key
+type (
+	weightKey  struct{}
+	versionKey struct{}
+)
+
+// Canary 服务的灰度发布配置
+type Canary struct {
+	Version string `json:"version"` // 灰度版本，与服务注册的 Server.Version 一致
+	Percent int    `json:"percent"` // 没有灰度标记的请求中转发到灰度版本的百分比
+}
+
+// canaryConfig 负载均衡策略的配置
+type canaryConfig struct {
+	serviceconfig.LoadBalancingConfig
+	Canary
+}
+
+func init() {
+	balancer.Register(wrrBuilder{})
+}
+
+// NewAddress 根据注册的服务信息生成解析结果中的地址，权重和版本保存在地址属性中
+func NewAddress(info Server) resolver.Address {
+	attrs := attributes.New(weightKey{}, info.Weight).WithValue(versionKey{}, info.Version)
+	return resolver.Address{Addr: info.Addr, Attributes: attrs}
+}
+
+// weightOf 返回地址的权重，没有设置权重时按 1 处理
+func weightOf(addr resolver.Address) int64 {
+	weight, _ := addr.Attributes.Value(weightKey{}).(int64)
+	if weight < 1 {
+		return 1
+	}
+	return weight
+}
+
+// versionOf 返回地址的版本
+func versionOf(addr resolver.Address) string {
+	version, _ := addr.Attributes.Value(versionKey{}).(string)
+	return version
+}
+
+// WithCanary 标记请求为灰度请求，之后的调用转发到各服务的灰度版本，没有配置灰度版本的服务不受影响
+func WithCanary(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, CanaryKey, "true")
+}
+
+// isCanary 判断调用是否为灰度请求
+func isCanary(ctx context.Context) bool {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	values := md.Get(CanaryKey)
+	return len(values) > 0 && values[0] == "true"
+}
+
+type wrrBuilder struct{}
+
+func (wrrBuilder) Name() string {
+	return WeightedRoundRobin
+}
+
+func (wrrBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
+	pb := &wrrPickerBuilder{}
+	return &wrrBalancer{
+		Balancer: base.NewBalancerBuilder(WeightedRoundRobin, pb, base.Config{}).Build(cc, opts),
+		pb:       pb,
+	}
+}
+
+// ParseConfig 解析服务配置中的灰度配置
+func (wrrBuilder) ParseConfig(js json.RawMessage) (serviceconfig.LoadBalancingConfig, error) {
+	cfg := &canaryConfig{}
+	if err := json.Unmarshal(js, &cfg.Canary); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// wrrBalancer 在 base 的负载均衡器上记录灰度配置，生成选择器时使用
+type wrrBalancer struct {
+	balancer.Balancer
+	pb *wrrPickerBuilder
+}
+
+func (b *wrrBalancer) UpdateClientConnState(s balancer.ClientConnState) error {
+	if cfg, ok := s.BalancerConfig.(*canaryConfig); ok {
+		b.pb.canary = cfg.Canary
+	}
+	return b.Balancer.UpdateClientConnState(s)
+}
+
+type wrrPickerBuilder struct {
+	canary Canary
+}
+
+// Build 连接状态变化时根据可用的连接生成新的选择器，配置了灰度版本时把节点分为灰度和稳定两组
+func (pb *wrrPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &wrrPicker{percent: pb.canary.Percent}
+	for sc, sci := range info.ReadySCs {
+		n := &wrrNode{sc: sc, weight: weightOf(sci.Address)}
+		if pb.canary.Version != "" && versionOf(sci.Address) == pb.canary.Version {
+			p.canary = append(p.canary, n)
+		} else {
+			p.stable = append(p.stable, n)
+		}
+	}
+	// 所有节点都是灰度版本时按普通的加权轮询处理
+	if len(p.stable) == 0 {
+		p.stable, p.canary = p.canary, nil
+	}
+	return p
+}
+
+type wrrNode struct {
+	sc      balancer.SubConn
+	weight  int64
+	current int64
+}
+
+type wrrPicker struct {
+	mu      sync.Mutex
+	stable  []*wrrNode
+	canary  []*wrrNode
+	percent int
+}
+
+// Pick 灰度请求和按比例抽取的请求在灰度节点中选择，其他请求在稳定节点中选择，没有可用的灰度节点时都使用稳定节点
+func (p *wrrPicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	nodes := p.stable
+	if len(p.canary) > 0 && (isCanary(info.Ctx) || rand.Intn(100) < p.percent) {
+		nodes = p.canary
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return balancer.PickResult{SubConn: pick(nodes).sc}, nil
+}
+
+// pick 平滑加权轮询（与 nginx 的算法相同）：每次选择时所有节点的当前权重加上各自的权重，
+// 选中当前权重最大的节点并减去总权重，权重高的节点被选中的次数多且不会连续集中在同一个节点
+func pick(nodes []*wrrNode) *wrrNode {
+	var total int64
+	var best *wrrNode
+	for _, n := range nodes {
+		n.current += n.weight
+		total += n.weight
+		if best == nil || n.current > best.current {
+			best = n
+		}
+	}
+	best.current -= total
+	return best
+}
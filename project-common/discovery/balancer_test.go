@@ -0,0 +1,120 @@
+package discovery
+
+import (
+	"context"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"strings"
+	"testing"
+)
+
+// fakeSubConn 只用于区分选中的节点
+type fakeSubConn struct {
+	balancer.SubConn
+	name string
+}
+
+func TestPickWeights(t *testing.T) {
+	cases := []struct {
+		name    string
+		weights []int64
+		rounds  int
+		want    []int
+		seq     string // 第一轮的选择顺序，为空时不检查
+	}{
+		{name: "equal", weights: []int64{1, 1, 1}, rounds: 10, want: []int{10, 10, 10}, seq: "abc"},
+		{name: "one to three", weights: []int64{1, 3}, rounds: 100, want: []int{100, 300}, seq: "babb"},
+		// nginx 平滑加权轮询的经典例子，权重高的节点不会连续集中被选中
+		{name: "smooth", weights: []int64{5, 1, 1}, rounds: 10, want: []int{50, 10, 10}, seq: "aabacaa"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var nodes []*wrrNode
+			var total int64
+			for i, w := range tc.weights {
+				nodes = append(nodes, &wrrNode{sc: &fakeSubConn{name: string(rune('a' + i))}, weight: w})
+				total += w
+			}
+			got := make([]int, len(nodes))
+			var seq strings.Builder
+			for i := 0; i < tc.rounds*int(total); i++ {
+				n := pick(nodes)
+				for j := range nodes {
+					if nodes[j] == n {
+						got[j]++
+					}
+				}
+				if i < int(total) {
+					seq.WriteString(n.sc.(*fakeSubConn).name)
+				}
+			}
+			for j := range got {
+				if got[j] != tc.want[j] {
+					t.Fatalf("picks = %v, want %v", got, tc.want)
+				}
+			}
+			if tc.seq != "" && seq.String() != tc.seq {
+				t.Fatalf("first round = %s, want %s", seq.String(), tc.seq)
+			}
+		})
+	}
+}
+
+func TestPickerCanary(t *testing.T) {
+	servers := map[string]Server{
+		"stable-1": {Addr: "stable-1", Version: "1.0.0", Weight: 1},
+		"stable-2": {Addr: "stable-2", Version: "1.0.0", Weight: 1},
+		"canary":   {Addr: "canary", Version: "1.1.0", Weight: 1},
+	}
+	cases := []struct {
+		name    string
+		nodes   []string
+		canary  Canary
+		flagged bool
+		want    []string // 可能被选中的节点
+	}{
+		{name: "no canary config", nodes: []string{"stable-1", "canary"}, want: []string{"stable-1", "canary"}},
+		{name: "no canary config flagged", nodes: []string{"stable-1", "canary"}, flagged: true, want: []string{"stable-1", "canary"}},
+		{name: "plain to stable", nodes: []string{"stable-1", "stable-2", "canary"}, canary: Canary{Version: "1.1.0"}, want: []string{"stable-1", "stable-2"}},
+		{name: "flagged to canary", nodes: []string{"stable-1", "stable-2", "canary"}, canary: Canary{Version: "1.1.0"}, flagged: true, want: []string{"canary"}},
+		{name: "full percent to canary", nodes: []string{"stable-1", "canary"}, canary: Canary{Version: "1.1.0", Percent: 100}, want: []string{"canary"}},
+		{name: "no canary node", nodes: []string{"stable-1", "stable-2"}, canary: Canary{Version: "1.1.0", Percent: 100}, flagged: true, want: []string{"stable-1", "stable-2"}},
+		{name: "all canary nodes", nodes: []string{"canary"}, canary: Canary{Version: "1.1.0"}, want: []string{"canary"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			info := base.PickerBuildInfo{ReadySCs: map[balancer.SubConn]base.SubConnInfo{}}
+			for _, name := range tc.nodes {
+				info.ReadySCs[&fakeSubConn{name: name}] = base.SubConnInfo{Address: NewAddress(servers[name])}
+			}
+			picker := (&wrrPickerBuilder{canary: tc.canary}).Build(info)
+			ctx := context.Background()
+			if tc.flagged {
+				ctx = WithCanary(ctx)
+			}
+			seen := map[string]bool{}
+			for i := 0; i < 100; i++ {
+				res, err := picker.Pick(balancer.PickInfo{Ctx: ctx})
+				if err != nil {
+					t.Fatal(err)
+				}
+				seen[res.SubConn.(*fakeSubConn).name] = true
+			}
+			if len(seen) != len(tc.want) {
+				t.Fatalf("picked %v, want %v", seen, tc.want)
+			}
+			for _, name := range tc.want {
+				if !seen[name] {
+					t.Fatalf("picked %v, want %v", seen, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPickerNoReadySubConn(t *testing.T) {
+	picker := (&wrrPickerBuilder{}).Build(base.PickerBuildInfo{})
+	if _, err := picker.Pick(balancer.PickInfo{Ctx: context.Background()}); err != balancer.ErrNoSubConnAvailable {
+		t.Fatalf("err = %v, want ErrNoSubConnAvailable", err)
+	}
+}
@@ -0,0 +1,98 @@
+package discovery
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"project-common/metrics"
+	"sync"
+	"time"
+)
+
+// 熔断器的状态
+const (
+	breakerClosed   = iota // 正常调用
+	breakerOpen            // 熔断中，调用直接失败
+	breakerHalfOpen        // 冷却时间已过，放行一个探测调用
+)
+
+// Breaker 熔断器：连续失败达到阈值后打开，打开期间的调用直接返回 Unavailable，不再等待超时；
+// 冷却时间过后放行一个探测调用，成功则关闭，失败则重新打开。
+// 只有服务不可用和超时计为失败，业务错误说明服务是健康的
+type Breaker struct {
+	target    string
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    int
+	failures int
+	openedAt time.Time
+}
+
+// NewBreaker 创建熔断器，threshold 为打开熔断器的连续失败次数，cooldown 为打开后的冷却时间
+func NewBreaker(target string, threshold int, cooldown time.Duration) *Breaker {
+	metrics.CircuitState(target, false)
+	return &Breaker{target: target, threshold: threshold, cooldown: cooldown}
+}
+
+// allow 判断是否放行本次调用
+func (b *Breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = breakerHalfOpen
+		return true
+	case breakerHalfOpen:
+		// 探测调用返回之前，其他调用直接失败
+		return false
+	}
+	return true
+}
+
+// done 记录调用的结果
+func (b *Breaker) done(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !breakerFailure(err) {
+		if b.state != breakerClosed {
+			metrics.CircuitState(b.target, false)
+		}
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+		metrics.CircuitState(b.target, true)
+	}
+}
+
+// breakerFailure 判断调用结果是否说明服务不健康
+func breakerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// UnaryClientInterceptor 返回使用熔断器的客户端拦截器，重试在拦截器内部进行，重试全部失败后才计为一次失败
+func (b *Breaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.allow() {
+			metrics.CircuitRejected(b.target)
+			return status.Errorf(codes.Unavailable, "%s 服务暂时不可用，请稍后再试", b.target)
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.done(err)
+		return err
+	}
+}
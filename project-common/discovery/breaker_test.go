@@ -0,0 +1,69 @@
+package discovery
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestBreakerTransitions(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	deadline := status.Error(codes.DeadlineExceeded, "deadline")
+	business := status.Error(codes.Unknown, "业务错误")
+	// 每一步先判断是否放行，done 为 true 时记录调用结果 err；cooled 为 true 时先让冷却时间过去
+	steps := []struct {
+		name   string
+		cooled bool
+		allow  bool
+		done   bool
+		err    error
+		state  int
+	}{
+		{name: "first failure", allow: true, done: true, err: unavailable, state: breakerClosed},
+		{name: "business error resets", allow: true, done: true, err: business, state: breakerClosed},
+		{name: "failure after reset", allow: true, done: true, err: unavailable, state: breakerClosed},
+		{name: "threshold opens", allow: true, done: true, err: deadline, state: breakerOpen},
+		{name: "rejected while open", allow: false, state: breakerOpen},
+		{name: "probe after cooldown", cooled: true, allow: true, state: breakerHalfOpen},
+		{name: "rejected during probe", allow: false, state: breakerHalfOpen},
+		{name: "probe failure reopens", allow: false, done: true, err: unavailable, state: breakerOpen},
+		{name: "rejected after reopen", allow: false, state: breakerOpen},
+		{name: "probe success closes", cooled: true, allow: true, done: true, state: breakerClosed},
+		{name: "closed again", allow: true, done: true, state: breakerClosed},
+	}
+	b := NewBreaker("test", 2, time.Hour)
+	for _, s := range steps {
+		if s.cooled {
+			b.openedAt = time.Now().Add(-2 * time.Hour)
+		}
+		if got := b.allow(); got != s.allow {
+			t.Fatalf("%s: allow = %v, want %v", s.name, got, s.allow)
+		}
+		if s.done {
+			b.done(s.err)
+		}
+		if b.state != s.state {
+			t.Fatalf("%s: state = %d, want %d", s.name, b.state, s.state)
+		}
+	}
+}
+
+func TestBreakerInterceptor(t *testing.T) {
+	b := NewBreaker("test", 1, time.Hour)
+	interceptor := b.UnaryClientInterceptor()
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.Unavailable, "unavailable")
+	}
+	if err := interceptor(context.Background(), "/m", nil, nil, nil, invoker); status.Code(err) != codes.Unavailable {
+		t.Fatalf("first call err = %v", err)
+	}
+	err := interceptor(context.Background(), "/m", nil, nil, nil, invoker)
+	if status.Code(err) != codes.Unavailable || calls != 1 {
+		t.Fatalf("open breaker should reject without invoking: err = %v, calls = %d", err, calls)
+	}
+}
@@ -0,0 +1,72 @@
+package discovery
+
+import (
+	"encoding/json"
+	"google.golang.org/grpc"
+	"sort"
+	"time"
+)
+
+const (
+	// callTimeout 调用方没有设置超时时间时，每次调用的最长时间（包括重试）
+	callTimeout = 10 * time.Second
+	// breakerThreshold 连续失败多少次后打开熔断器
+	breakerThreshold = 5
+	// breakerCooldown 熔断器打开后的冷却时间
+	breakerCooldown = 10 * time.Second
+)
+
+// RetryMethods 服务不可用时可以安全重试的只读方法，key 为服务全名（如 login.service.v1.LoginService），value 为方法名
+type RetryMethods map[string][]string
+
+// retryPolicy 只读方法的重试策略，只重试服务不可用，超时不重试
+var retryPolicy = map[string]any{
+	"maxAttempts":          3,
+	"initialBackoff":       "0.1s",
+	"maxBackoff":           "1s",
+	"backoffMultiplier":    2,
+	"retryableStatusCodes": []string{"UNAVAILABLE"},
+}
+
+// Dial 通过 etcd 解析 app 服务的地址并建立连接，需要先用 resolver.Register 注册 NewResolver 创建的解析器。
+// 连接按注册的权重平滑加权轮询，canary 不为空时灰度请求和按比例抽取的请求转发到灰度版本；
+// 每次调用有默认的超时时间，retry 中的方法在服务不可用时重试，连续失败时熔断，直接返回 Unavailable
+func Dial(app string, retry RetryMethods, canary *Canary, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	breaker := NewBreaker(app, breakerThreshold, breakerCooldown)
+	opts = append(opts,
+		grpc.WithDefaultServiceConfig(serviceConfig(retry, canary)),
+		grpc.WithChainUnaryInterceptor(breaker.UnaryClientInterceptor()),
+	)
+	return grpc.NewClient(BuildResolverUrl(app), opts...)
+}
+
+// serviceConfig 生成连接的服务配置：负载均衡策略和灰度配置、默认超时时间和只读方法的重试策略
+func serviceConfig(retry RetryMethods, canary *Canary) string {
+	timeout := callTimeout.String()
+	methodConfig := []map[string]any{
+		// name 中只有空对象时是所有方法的默认配置
+		{"name": []map[string]string{{}}, "timeout": timeout},
+	}
+	services := make([]string, 0, len(retry))
+	for service := range retry {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	var names []map[string]string
+	for _, service := range services {
+		for _, method := range retry[service] {
+			names = append(names, map[string]string{"service": service, "method": method})
+		}
+	}
+	if len(names) > 0 {
+		methodConfig = append(methodConfig, map[string]any{"name": names, "timeout": timeout, "retryPolicy": retryPolicy})
+	}
+	if canary == nil {
+		canary = &Canary{}
+	}
+	bytes, _ := json.Marshal(map[string]any{
+		"loadBalancingConfig": []map[string]any{{WeightedRoundRobin: canary}},
+		"methodConfig":        methodConfig,
+	})
+	return string(bytes)
+}
@@ -44,16 +44,21 @@ func (r *Resolver) Scheme() string {
 	return r.schema
 }
 
-// Build creates a new resolver.Resolver for the given target
+// Build creates a new resolver.Resolver for the given target.
+// 每个连接使用单独的解析器，etcd:///user 解析所有版本的 user 服务，etcd://1.0.0/user 只解析指定版本
 func (r *Resolver) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
-	r.cc = cc
-
-	//r.keyPrifix = BuildPrefix(Server{Name: target.Endpoint, Version: target.Authority})
-	r.keyPrifix = BuildPrefix(Server{Name: target.Endpoint(), Version: target.String()})
-	if _, err := r.start(); err != nil {
+	tr := &Resolver{
+		schema:      r.schema,
+		EtcdAddrs:   r.EtcdAddrs,
+		DialTimeout: r.DialTimeout,
+		cc:          cc,
+		keyPrifix:   BuildPrefix(Server{Name: target.Endpoint(), Version: target.URL.Host}),
+		logger:      r.logger,
+	}
+	if _, err := tr.start(); err != nil {
 		return nil, err
 	}
-	return r, nil
+	return tr, nil
 }
 
 // ResolveNow resolver.Resolver interface
@@ -62,6 +67,7 @@ func (r *Resolver) ResolveNow(o resolver.ResolveNowOptions) {}
 // Close resolver.Resolver interface
 func (r *Resolver) Close() {
 	r.closeCh <- struct{}{}
+	r.cli.Close()
 }
 
 // start
@@ -74,7 +80,6 @@ func (r *Resolver) start() (chan<- struct{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	resolver.Register(r)
 
 	r.closeCh = make(chan struct{})
 
@@ -120,11 +125,13 @@ func (r *Resolver) update(events []*clientv3.Event) {
 			if err != nil {
 				continue
 			}
-			addr := resolver.Address{Addr: info.Addr, Metadata: info.Weight}
-			if !Exist(r.srvAddrsList, addr) {
-				r.srvAddrsList = append(r.srvAddrsList, addr)
-				r.cc.UpdateState(resolver.State{Addresses: r.srvAddrsList})
+			// 修改权重时同一地址会再次写入，替换原来的地址
+			addr := NewAddress(info)
+			if s, ok := Remove(r.srvAddrsList, addr); ok {
+				r.srvAddrsList = s
 			}
+			r.srvAddrsList = append(r.srvAddrsList, addr)
+			r.cc.UpdateState(resolver.State{Addresses: r.srvAddrsList})
 		case mvccpb.DELETE:
 			info, err = SplitPath(string(ev.Kv.Key))
 			if err != nil {
@@ -154,8 +161,7 @@ func (r *Resolver) sync() error {
 		if err != nil {
 			continue
 		}
-		addr := resolver.Address{Addr: info.Addr, Metadata: info.Weight}
-		r.srvAddrsList = append(r.srvAddrsList, addr)
+		r.srvAddrsList = append(r.srvAddrsList, NewAddress(info))
 	}
 	r.cc.UpdateState(resolver.State{Addresses: r.srvAddrsList})
 	return nil
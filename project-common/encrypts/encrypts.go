@@ -4,6 +4,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/hex"
 	"io"
 	"strconv"
@@ -17,6 +18,12 @@ func Md5(str string) string {
 	return hex.EncodeToString(hash.Sum(nil))
 }
 
+// Sha256 计算给定字符串的SHA256哈希值，用于保存恢复码等高熵的一次性凭证。
+func Sha256(str string) string {
+	sum := sha256.Sum256([]byte(str))
+	return hex.EncodeToString(sum[:])
+}
+
 // commonIV 是用于AES加密器的通用初始化向量。
 // 初始化向量(IV)是一个固定长度的输入，对于使用相同密钥的每个加密操作，它使得加密输出唯一。
 var commonIV = []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
@@ -22,3 +22,23 @@ func TestEncrypt(t *testing.T) {
 	}
 	fmt.Printf("%s ==> %s\n", cipherByte, plainText)
 }
+
+func TestVerifyPassword(t *testing.T) {
+	hash, err := HashPassword("123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, needRehash := VerifyPassword(hash, "123456"); !ok || needRehash {
+		t.Fatalf("argon2id hash should verify without rehash, ok=%v needRehash=%v", ok, needRehash)
+	}
+	if ok, _ := VerifyPassword(hash, "1234567"); ok {
+		t.Fatal("wrong password should not verify")
+	}
+	// 旧版本的 md5 哈希仍然可以登录，但需要升级
+	if ok, needRehash := VerifyPassword(Md5("123456"), "123456"); !ok || !needRehash {
+		t.Fatalf("md5 hash should verify and need rehash, ok=%v needRehash=%v", ok, needRehash)
+	}
+	if ok, _ := VerifyPassword("$argon2id$broken", "123456"); ok {
+		t.Fatal("malformed hash should not verify")
+	}
+}
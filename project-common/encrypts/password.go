@@ -0,0 +1,103 @@
+package encrypts
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"regexp"
+	"strings"
+)
+
+// Argon2Params argon2id 的计算参数
+type Argon2Params struct {
+	Memory  uint32 // 内存开销，单位KB
+	Time    uint32 // 迭代次数
+	Threads uint8  // 并行度
+	SaltLen uint32 // 盐的长度
+	KeyLen  uint32 // 哈希结果的长度
+}
+
+// DefaultArgon2Params 默认的 argon2id 参数，参数调整后旧的哈希会在用户下次登录时升级
+var DefaultArgon2Params = &Argon2Params{
+	Memory:  64 * 1024,
+	Time:    1,
+	Threads: 4,
+	SaltLen: 16,
+	KeyLen:  32,
+}
+
+// argon2idPrefix argon2id 哈希字符串的前缀
+const argon2idPrefix = "$argon2id$"
+
+// md5Pattern 旧版本使用的无盐 md5 哈希
+var md5Pattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+var errInvalidHash = errors.New("密码哈希格式不正确")
+
+// HashPassword 使用默认参数对密码进行 argon2id 哈希。
+// 返回自描述的哈希字符串，格式为 $argon2id$v=19$m=65536,t=1,p=4$<salt>$<hash>，
+// 算法、版本和参数都保存在字符串中，校验时不依赖当前的配置。
+func HashPassword(password string) (string, error) {
+	p := DefaultArgon2Params
+	salt := make([]byte, p.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, p.KeyLen)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2idPrefix, argon2.Version, p.Memory, p.Time, p.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// VerifyPassword 校验密码是否与哈希匹配。
+// 除了 argon2id 哈希外还兼容旧版本的 md5 哈希。
+// needRehash 为 true 表示密码正确但哈希使用的是旧算法或旧参数，调用方应当用 HashPassword 重新生成并保存。
+func VerifyPassword(hash string, password string) (ok bool, needRehash bool) {
+	if md5Pattern.MatchString(hash) {
+		return subtle.ConstantTimeCompare([]byte(hash), []byte(Md5(password))) == 1, true
+	}
+	p, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return false, false
+	}
+	other := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, p.KeyLen)
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return false, false
+	}
+	d := DefaultArgon2Params
+	needRehash = p.Memory != d.Memory || p.Time != d.Time || p.Threads != d.Threads ||
+		p.SaltLen != d.SaltLen || p.KeyLen != d.KeyLen
+	return true, needRehash
+}
+
+// decodeArgon2id 解析 argon2id 哈希字符串中的参数、盐和哈希结果
+func decodeArgon2id(hash string) (*Argon2Params, []byte, []byte, error) {
+	if !strings.HasPrefix(hash, argon2idPrefix) {
+		return nil, nil, nil, errInvalidHash
+	}
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return nil, nil, nil, errInvalidHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, errInvalidHash
+	}
+	p := &Argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil {
+		return nil, nil, nil, errInvalidHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, errInvalidHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, nil, nil, errInvalidHash
+	}
+	p.SaltLen = uint32(len(salt))
+	p.KeyLen = uint32(len(key))
+	return p, salt, key, nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"time"
 )
 
@@ -13,6 +14,28 @@ type JwtToken struct {
 	RefreshToken string // 刷新令牌
 	AccessExp    int64  // 访问令牌过期时间
 	RefreshExp   int64  // 刷新令牌过期时间
+	AccessId     string // 访问令牌的唯一标识（jti）
+	RefreshId    string // 刷新令牌的唯一标识（jti）
+	Family       string // 令牌家族，同一次登录轮换出来的令牌属于同一家族
+}
+
+// TokenOption 签发令牌时附加的声明
+type TokenOption struct {
+	Ip      string // 签发时的ip，只写入访问令牌
+	Family  string // 令牌家族，为空时表示一次新的登录，会生成新的家族标识
+	Version int64  // 令牌版本，用户退出所有设备后版本递增，旧版本的令牌全部失效
+	Org     int64  // 当前选择的组织，为0时使用成员的默认组织
+}
+
+// TokenClaims 令牌中携带的声明
+type TokenClaims struct {
+	Val     string // 令牌的值
+	Jti     string // 令牌的唯一标识
+	Family  string // 令牌家族
+	Ip      string // 签发时的ip
+	Exp     int64  // 过期时间
+	Version int64  // 令牌版本
+	Org     int64  // 当前选择的组织
 }
 
 // CreateToken 生成访问令牌和刷新令牌。
@@ -28,26 +51,64 @@ type JwtToken struct {
 //
 //	*JwtToken: 生成的令牌结构体指针
 func CreateToken(val string, exp time.Duration, secret string, refreshExp time.Duration, refreshSecret string, ip string) *JwtToken {
+	return CreateTokenWithOption(val, exp, secret, refreshExp, refreshSecret, TokenOption{Ip: ip})
+}
+
+// CreateTokenWithOption 生成访问令牌和刷新令牌，并写入 opt 中附加的声明。
+// 两个令牌都带有各自的 jti，刷新令牌轮换时沿用旧的家族标识。
+func CreateTokenWithOption(val string, exp time.Duration, secret string, refreshExp time.Duration, refreshSecret string, opt TokenOption) *JwtToken {
+	token, _ := createToken(val, exp, func(claims jwt.MapClaims) (string, error) {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	}, refreshExp, refreshSecret, opt)
+	return token
+}
+
+// CreateTokenWithKeySet 使用密钥集合中的签名密钥（RS256/EdDSA）签发访问令牌，
+// 其他服务只需要公钥就能校验访问令牌；刷新令牌只由签发方自己校验，仍然使用 refreshSecret。
+func CreateTokenWithKeySet(val string, exp time.Duration, ks *KeySet, refreshExp time.Duration, refreshSecret string, opt TokenOption) (*JwtToken, error) {
+	return createToken(val, exp, ks.Sign, refreshExp, refreshSecret, opt)
+}
+
+// createToken 生成访问令牌和刷新令牌，访问令牌由 signAccess 签名
+func createToken(val string, exp time.Duration, signAccess func(jwt.MapClaims) (string, error), refreshExp time.Duration, refreshSecret string, opt TokenOption) (*JwtToken, error) {
+	family := opt.Family
+	if family == "" {
+		family = uuid.NewString()
+	}
 	// 计算访问令牌的过期时间
 	aExp := time.Now().Add(exp).Unix()
-	// 创建访问令牌
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"token": val,
-		"exp":   aExp,
-		"ip":    ip,
+	aId := uuid.NewString()
+	// 创建并签发访问令牌
+	aToken, err := signAccess(jwt.MapClaims{
+		"token":  val,
+		"exp":    aExp,
+		"ip":     opt.Ip,
+		"jti":    aId,
+		"family": family,
+		"ver":    opt.Version,
+		"org":    opt.Org,
 	})
-	// 签发访问令牌
-	aToken, _ := accessToken.SignedString([]byte(secret))
+	if err != nil {
+		return nil, err
+	}
 
 	// 计算刷新令牌的过期时间
 	rExp := time.Now().Add(refreshExp).Unix()
+	rId := uuid.NewString()
 	// 创建刷新令牌
 	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"token": val,
-		"exp":   aExp,
+		"token":  val,
+		"exp":    rExp,
+		"jti":    rId,
+		"family": family,
+		"ver":    opt.Version,
+		"org":    opt.Org,
 	})
 	// 签发刷新令牌
-	rToken, _ := refreshToken.SignedString([]byte(refreshSecret))
+	rToken, err := refreshToken.SignedString([]byte(refreshSecret))
+	if err != nil {
+		return nil, err
+	}
 
 	// 返回令牌结构体
 	return &JwtToken{
@@ -55,7 +116,10 @@ func CreateToken(val string, exp time.Duration, secret string, refreshExp time.D
 		AccessToken:  aToken,
 		RefreshExp:   rExp,
 		RefreshToken: rToken,
-	}
+		AccessId:     aId,
+		RefreshId:    rId,
+		Family:       family,
+	}, nil
 }
 
 func ParseTokenOld(tokenString string, secret string) (string, error) {
@@ -127,3 +191,52 @@ func ParseToken(tokenString string, secret string, ip string) (string, error) {
 		return "", err
 	}
 }
+
+// ParseClaims 解析令牌并返回其中携带的声明，不校验ip。
+func ParseClaims(tokenString string, secret string) (*TokenClaims, error) {
+	return parseClaims(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(secret), nil
+	})
+}
+
+// ParseClaimsWithKeySet 使用密钥集合校验非对称签名的令牌并返回其中携带的声明，不校验ip。
+// 令牌头部的 kid 必须是集合中的密钥。
+func ParseClaimsWithKeySet(tokenString string, ks *KeySet) (*TokenClaims, error) {
+	return parseClaims(tokenString, ks.keyfunc)
+}
+
+// parseClaims 使用 keyfunc 校验令牌签名并解析声明
+func parseClaims(tokenString string, keyfunc jwt.Keyfunc) (*TokenClaims, error) {
+	token, err := jwt.Parse(tokenString, keyfunc)
+	if err != nil {
+		return nil, err
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("token不合法")
+	}
+	tc := &TokenClaims{}
+	tc.Val, _ = claims["token"].(string)
+	tc.Jti, _ = claims["jti"].(string)
+	tc.Family, _ = claims["family"].(string)
+	tc.Ip, _ = claims["ip"].(string)
+	if exp, ok := claims["exp"].(float64); ok {
+		tc.Exp = int64(exp)
+	}
+	if ver, ok := claims["ver"].(float64); ok {
+		tc.Version = int64(ver)
+	}
+	if org, ok := claims["org"].(float64); ok {
+		tc.Org = int64(org)
+	}
+	if tc.Val == "" {
+		return nil, errors.New("token不合法")
+	}
+	if tc.Exp <= time.Now().Unix() {
+		return nil, errors.New("token过期了")
+	}
+	return tc, nil
+}
@@ -0,0 +1,217 @@
+package jwts
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"math/big"
+)
+
+// 支持的非对称签名算法
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// Key 带有 kid 的签名密钥，Private 为空时只能用于校验
+type Key struct {
+	Kid     string
+	Alg     string
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+// method 返回密钥对应的签名方法
+func (k *Key) method() jwt.SigningMethod {
+	if k.Alg == AlgEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// ParsePrivateKeyPEM 解析PEM格式的私钥，支持 PKCS8 的 RSA/Ed25519 私钥和 PKCS1 的 RSA 私钥，
+// 签名算法根据密钥类型确定
+func ParsePrivateKeyPEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("私钥不是PEM格式")
+	}
+	var priv any
+	var err error
+	if block.Type == "RSA PRIVATE KEY" {
+		priv, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	} else {
+		priv, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch k := priv.(type) {
+	case *rsa.PrivateKey:
+		return &Key{Kid: kid, Alg: AlgRS256, Private: k, Public: &k.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return &Key{Kid: kid, Alg: AlgEdDSA, Private: k, Public: k.Public()}, nil
+	}
+	return nil, fmt.Errorf("不支持的私钥类型: %T", priv)
+}
+
+// ParsePublicKeyPEM 解析PEM格式（PKIX）的公钥，用于校验轮换前签发的令牌
+func ParsePublicKeyPEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("公钥不是PEM格式")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return &Key{Kid: kid, Alg: AlgRS256, Public: k}, nil
+	case ed25519.PublicKey:
+		return &Key{Kid: kid, Alg: AlgEdDSA, Public: k}, nil
+	}
+	return nil, fmt.Errorf("不支持的公钥类型: %T", pub)
+}
+
+// KeySet 一组同时有效的密钥。
+// 签发令牌只使用签名密钥，校验时根据令牌头部的 kid 选择密钥，
+// 轮换密钥时旧密钥保留在集合中，直到用它签发的令牌全部过期。
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+// NewKeySet 创建密钥集合，signing 为空时集合只能用于校验
+func NewKeySet(signing *Key, verify ...*Key) *KeySet {
+	ks := &KeySet{signing: signing, keys: make(map[string]*Key)}
+	if signing != nil {
+		ks.keys[signing.Kid] = signing
+	}
+	for _, k := range verify {
+		ks.keys[k.Kid] = k
+	}
+	return ks
+}
+
+// Has 判断集合中是否有指定 kid 的密钥
+func (ks *KeySet) Has(kid string) bool {
+	_, ok := ks.keys[kid]
+	return ok
+}
+
+// Sign 使用签名密钥签发令牌，令牌头部写入签名密钥的 kid
+func (ks *KeySet) Sign(claims jwt.MapClaims) (string, error) {
+	if ks.signing == nil || ks.signing.Private == nil {
+		return "", errors.New("没有可用的签名密钥")
+	}
+	token := jwt.NewWithClaims(ks.signing.method(), claims)
+	token.Header["kid"] = ks.signing.Kid
+	return token.SignedString(ks.signing.Private)
+}
+
+// keyfunc 根据令牌头部的 kid 查找校验密钥，令牌的算法必须与密钥一致
+func (ks *KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	k, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("未知的密钥: %s", kid)
+	}
+	if token.Method.Alg() != k.Alg {
+		return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+	}
+	return k.Public, nil
+}
+
+// JWK JSON Web Key，只包含公钥部分
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JWKS JSON Web Key Set 文档
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS 导出集合中所有密钥的公钥
+func (ks *KeySet) JWKS() *JWKS {
+	doc := &JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+	for _, k := range ks.keys {
+		jwk := JWK{Kid: k.Kid, Alg: k.Alg, Use: "sig"}
+		switch pub := k.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+		doc.Keys = append(doc.Keys, jwk)
+	}
+	return doc
+}
+
+// ParseJWKS 解析 JWKS 文档，返回只能用于校验的密钥集合，不认识的密钥会被忽略
+func ParseJWKS(data []byte) (*KeySet, error) {
+	doc := &JWKS{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	ks := NewKeySet(nil)
+	for _, jwk := range doc.Keys {
+		switch {
+		case jwk.Kty == "RSA" && jwk.Alg == AlgRS256:
+			n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+			if err != nil {
+				return nil, err
+			}
+			e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+			if err != nil {
+				return nil, err
+			}
+			pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+			ks.keys[jwk.Kid] = &Key{Kid: jwk.Kid, Alg: AlgRS256, Public: pub}
+		case jwk.Kty == "OKP" && jwk.Crv == "Ed25519":
+			x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+			if err != nil {
+				return nil, err
+			}
+			if len(x) != ed25519.PublicKeySize {
+				return nil, errors.New("Ed25519公钥长度不正确")
+			}
+			ks.keys[jwk.Kid] = &Key{Kid: jwk.Kid, Alg: AlgEdDSA, Public: ed25519.PublicKey(x)}
+		}
+	}
+	if len(ks.keys) == 0 {
+		return nil, errors.New("JWKS中没有可用的密钥")
+	}
+	return ks, nil
+}
+
+// KeyId 读取令牌头部的 kid，不校验签名，用于在校验前选择密钥
+func KeyId(tokenString string) string {
+	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return ""
+	}
+	kid, _ := token.Header["kid"].(string)
+	return kid
+}
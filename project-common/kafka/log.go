@@ -3,7 +3,9 @@ package kafka
 
 // 引入必要的包
 import (
+	"context"
 	"encoding/json"
+	"go.opentelemetry.io/otel/trace"
 	"project-common/tms"
 	"time"
 )
@@ -20,14 +22,16 @@ type KafkaLog struct {
 	Msg      string   // 消息内容
 	Field    FieldMap // 自定义字段
 	FuncName string   // 函数名称
+	TraceId  string   // 链路追踪的traceId，用于关联网关和各服务的日志
 }
 
 // Error生成一个错误类型的日志消息。
+// 参数ctx是请求的上下文，用于读取traceId。
 // 参数err是错误类型，代表发生了错误。
 // 参数funcName是字符串类型，代表错误发生的函数名称。
 // 参数fieldMap是FieldMap类型，包含与日志相关的自定义字段。
 // 返回值是编码后的日志消息的字节切片。
-func Error(err error, funcName string, fieldMap FieldMap) []byte {
+func Error(ctx context.Context, err error, funcName string, fieldMap FieldMap) []byte {
 	// 创建KafkaLog结构体实例
 	kl := KafkaLog{
 		Type:     "error",
@@ -36,6 +40,7 @@ func Error(err error, funcName string, fieldMap FieldMap) []byte {
 		Msg:      err.Error(),
 		Field:    fieldMap,
 		FuncName: funcName,
+		TraceId:  traceId(ctx),
 	}
 	// 将KafkaLog结构体编码为JSON格式
 	bytes, _ := json.Marshal(kl)
@@ -43,11 +48,12 @@ func Error(err error, funcName string, fieldMap FieldMap) []byte {
 }
 
 // Info生成一个信息类型的日志消息。
+// 参数ctx是请求的上下文，用于读取traceId。
 // 参数msg是字符串类型，代表日志的消息内容。
 // 参数funcName是字符串类型，代表日志发生的函数名称。
 // 参数fieldMap是FieldMap类型，包含与日志相关的自定义字段。
 // 返回值是编码后的日志消息的字节切片。
-func Info(msg string, funcName string, fieldMap FieldMap) []byte {
+func Info(ctx context.Context, msg string, funcName string, fieldMap FieldMap) []byte {
 	// 创建KafkaLog结构体实例
 	kl := KafkaLog{
 		Type:     "info",
@@ -56,8 +62,18 @@ func Info(msg string, funcName string, fieldMap FieldMap) []byte {
 		Msg:      msg,
 		Field:    fieldMap,
 		FuncName: funcName,
+		TraceId:  traceId(ctx),
 	}
 	// 将KafkaLog结构体编码为JSON格式
 	bytes, _ := json.Marshal(kl)
 	return bytes
 }
+
+// traceId 返回上下文中的traceId，没有trace时返回空字符串
+func traceId(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/segmentio/kafka-go"
 	"log"
+	"project-common/metrics"
 	"time"
 )
 
@@ -50,47 +51,52 @@ func GetWriter(addr string) *KafkaWriter {
 }
 
 // sendKafka 是用于向 Kafka 发送消息的核心方法。
-// 该方法在一个无限循环中运行，监听通道中的数据，并尝试将数据发送到 Kafka。
-// 每次发送最多重试 3 次，如果因为 Leader 不可用或超时导致失败，则会短暂等待后重试。
+// 该方法在一个无限循环中运行，监听通道中的数据，并逐条发送到 Kafka。
 func (w *KafkaWriter) sendKafka() {
-	for {
-		select {
-		case data := <-w.data: // 从通道中接收要发送的日志数据
-			// 构建要发送的 Kafka 消息
-			messages := []kafka.Message{
-				{
-					Topic: data.Topic,       // 消息的主题
-					Key:   []byte("logMsg"), // 消息的键，统一设置为 "logMsg"
-					Value: data.Data,        // 消息的实际内容
-				},
-			}
-			var err error
-			const retries = 3 // 设置最大重试次数为 3 次
+	for data := range w.data {
+		metrics.KafkaQueueDepth(len(w.data))
+		w.write(data)
+	}
+}
 
-			// 创建一个带有超时的上下文，防止发送过程无限阻塞
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancel() // 确保在函数退出前释放上下文资源
+// write 发送一条日志数据，最多重试 3 次，如果因为 Leader 不可用或超时导致失败，则会短暂等待后重试。
+// 重试和最终失败的次数记录在 Prometheus 指标中。
+func (w *KafkaWriter) write(data LogData) {
+	// 构建要发送的 Kafka 消息
+	messages := []kafka.Message{
+		{
+			Topic: data.Topic,       // 消息的主题
+			Key:   []byte("logMsg"), // 消息的键，统一设置为 "logMsg"
+			Value: data.Data,        // 消息的实际内容
+		},
+	}
+	var err error
+	const retries = 3 // 设置最大重试次数为 3 次
 
-			for i := 0; i < retries; i++ {
-				// 尝试发送消息到 Kafka
-				err = w.w.WriteMessages(ctx, messages...)
-				if err == nil {
-					break // 如果发送成功，跳出重试循环
-				}
+	// 创建一个带有超时的上下文，防止发送过程无限阻塞
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel() // 确保发送结束后释放上下文资源
 
-				// 如果错误是由于 Leader 不可用或者超时，则等待一段时间后重试
-				if errors.Is(err, kafka.LeaderNotAvailable) || errors.Is(err, context.DeadlineExceeded) {
-					time.Sleep(time.Millisecond * 250) // 等待 250 毫秒后重试
-					continue
-				}
+	for i := 0; i < retries; i++ {
+		if i > 0 {
+			metrics.KafkaSendRetried(data.Topic)
+		}
+		// 尝试发送消息到 Kafka
+		err = w.w.WriteMessages(ctx, messages...)
+		if err == nil {
+			return // 如果发送成功，直接返回
+		}
 
-				// 如果出现其他未知错误，记录日志
-				if err != nil {
-					log.Printf("kafka send writemessage err %s \n", err.Error())
-				}
-			}
+		// 如果错误是由于 Leader 不可用或者超时，则等待一段时间后重试
+		if errors.Is(err, kafka.LeaderNotAvailable) || errors.Is(err, context.DeadlineExceeded) {
+			time.Sleep(time.Millisecond * 250) // 等待 250 毫秒后重试
+			continue
 		}
+
+		// 如果出现其他未知错误，记录日志
+		log.Printf("kafka send writemessage err %s \n", err.Error())
 	}
+	metrics.KafkaSendFailed(data.Topic)
 }
 
 // KafkaWriter的Send方法用于向Kafka发送日志数据。
@@ -98,6 +104,7 @@ func (w *KafkaWriter) sendKafka() {
 // 这种设计允许KafkaWriter以非阻塞的方式异步发送日志数据，提高了性能和响应速度。
 func (w *KafkaWriter) Send(data LogData) {
 	w.data <- data
+	metrics.KafkaQueueDepth(len(w.data))
 }
 
 // KafkaWriter的Close方法用于关闭与Kafka的连接。
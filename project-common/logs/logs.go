@@ -1,8 +1,10 @@
 package logs
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/natefinch/lumberjack"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"net"
@@ -168,3 +170,12 @@ func GinRecovery(stack bool) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// Ctx 返回带有 traceId 的 logger，上下文中没有 trace 时返回全局的 logger
+func Ctx(ctx context.Context) *zap.Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return zap.L()
+	}
+	return zap.L().With(zap.String("traceId", sc.TraceID().String()))
+}
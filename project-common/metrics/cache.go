@@ -0,0 +1,23 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "cache",
+	Name:      "requests_total",
+	Help:      "接口结果缓存的查询次数，result 为 hit 或 miss",
+}, []string{"method", "result"})
+
+// CacheHit 记录一次缓存命中
+func CacheHit(method string) {
+	cacheRequests.WithLabelValues(method, "hit").Inc()
+}
+
+// CacheMiss 记录一次缓存未命中
+func CacheMiss(method string) {
+	cacheRequests.WithLabelValues(method, "miss").Inc()
+}
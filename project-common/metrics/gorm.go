@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+	"time"
+)
+
+var dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "db",
+	Name:      "query_duration_seconds",
+	Help:      "GORM 执行数据库操作的耗时",
+	Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+}, []string{"operation", "table"})
+
+// gormStartKey 保存操作开始时间的 key
+const gormStartKey = "metrics:start"
+
+// GormPlugin 记录 GORM 每次增删改查的耗时，通过 db.Use(metrics.GormPlugin{}) 注册
+type GormPlugin struct{}
+
+// Name 插件名称
+func (GormPlugin) Name() string {
+	return "metrics"
+}
+
+// Initialize 在 GORM 的各个操作前后注册回调
+func (GormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	errs := []error{
+		cb.Create().Before("gorm:create").Register("metrics:before_create", gormBefore),
+		cb.Create().After("gorm:create").Register("metrics:after_create", gormAfter("create")),
+		cb.Query().Before("gorm:query").Register("metrics:before_query", gormBefore),
+		cb.Query().After("gorm:query").Register("metrics:after_query", gormAfter("query")),
+		cb.Update().Before("gorm:update").Register("metrics:before_update", gormBefore),
+		cb.Update().After("gorm:update").Register("metrics:after_update", gormAfter("update")),
+		cb.Delete().Before("gorm:delete").Register("metrics:before_delete", gormBefore),
+		cb.Delete().After("gorm:delete").Register("metrics:after_delete", gormAfter("delete")),
+		cb.Row().Before("gorm:row").Register("metrics:before_row", gormBefore),
+		cb.Row().After("gorm:row").Register("metrics:after_row", gormAfter("row")),
+		cb.Raw().Before("gorm:raw").Register("metrics:before_raw", gormBefore),
+		cb.Raw().After("gorm:raw").Register("metrics:after_raw", gormAfter("raw")),
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func gormBefore(db *gorm.DB) {
+	db.InstanceSet(gormStartKey, time.Now())
+}
+
+func gormAfter(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(gormStartKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+		dbQueryDuration.WithLabelValues(operation, db.Statement.Table).Observe(time.Since(start).Seconds())
+	}
+}
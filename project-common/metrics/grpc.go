@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	grpcHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "gRPC 服务端处理的调用次数",
+	}, []string{"method", "code"})
+	grpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "gRPC 服务端处理调用的耗时",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	circuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_client",
+		Name:      "circuit_open",
+		Help:      "gRPC 客户端的熔断器是否打开，1 为打开",
+	}, []string{"target"})
+	circuitRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_client",
+		Name:      "circuit_rejected_total",
+		Help:      "熔断期间直接失败的调用次数",
+	}, []string{"target"})
+)
+
+// UnaryServerInterceptor 返回记录每个方法调用次数、状态码和耗时的服务端拦截器
+func UnaryServerInterceptor() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		grpcHandled.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		grpcDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+		return resp, err
+	})
+}
+
+// CircuitState 记录熔断器的状态
+func CircuitState(target string, open bool) {
+	v := 0.0
+	if open {
+		v = 1
+	}
+	circuitOpen.WithLabelValues(target).Set(v)
+}
+
+// CircuitRejected 记录一次熔断期间直接失败的调用
+func CircuitRejected(target string) {
+	circuitRejected.WithLabelValues(target).Inc()
+}
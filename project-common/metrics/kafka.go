@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	kafkaQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "kafka_writer",
+		Name:      "queue_depth",
+		Help:      "KafkaWriter 中等待发送的消息数",
+	})
+	kafkaSendFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "kafka_writer",
+		Name:      "send_failures_total",
+		Help:      "重试后仍然发送失败的消息数",
+	}, []string{"topic"})
+	kafkaSendRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "kafka_writer",
+		Name:      "send_retries_total",
+		Help:      "发送消息的重试次数",
+	}, []string{"topic"})
+)
+
+// KafkaQueueDepth 记录 KafkaWriter 中等待发送的消息数
+func KafkaQueueDepth(n int) {
+	kafkaQueueDepth.Set(float64(n))
+}
+
+// KafkaSendFailed 记录一条发送失败的消息
+func KafkaSendFailed(topic string) {
+	kafkaSendFailures.WithLabelValues(topic).Inc()
+}
+
+// KafkaSendRetried 记录一次发送重试
+func KafkaSendRetried(topic string) {
+	kafkaSendRetries.WithLabelValues(topic).Inc()
+}
@@ -0,0 +1,17 @@
+// Package metrics 提供各服务共用的 Prometheus 指标。
+// 指标注册在默认的 Registerer 中，/metrics 接口同时包含 Go 运行时和进程的指标，
+// 可以持续观察内存、goroutine 和 GC，不再需要手动采集 pprof。
+package metrics
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace 所有指标的前缀
+const namespace = "msproject"
+
+// Handler 返回 /metrics 接口的处理函数
+func Handler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
@@ -0,0 +1,42 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCode(t *testing.T) {
+	// RFC 6238 附录B的测试向量，密钥为ASCII的"12345678901234567890"
+	secret := b32.EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for ts, want := range cases {
+		got, err := Code(secret, time.Unix(ts, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("Code(%d) = %s, want %s", ts, got, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	code, _ := Code(secret, now.Add(-Period*time.Second))
+	if _, ok := Validate(secret, code, now); !ok {
+		t.Fatal("code from previous step should be accepted")
+	}
+	code, _ = Code(secret, now.Add(-3*Period*time.Second))
+	if _, ok := Validate(secret, code, now); ok {
+		t.Fatal("code outside the skew window should be rejected")
+	}
+}
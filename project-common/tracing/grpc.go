@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"project-common/logs"
+	"time"
+)
+
+// ServerOptions 返回 gRPC 服务端的链路追踪选项：从 metadata 中恢复 trace 并记录每次调用的日志
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(serverLog),
+	}
+}
+
+// DialOptions 返回 gRPC 客户端的链路追踪选项：把 trace 写入 metadata 并记录失败的调用
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(clientLog),
+	}
+}
+
+// serverLog 记录每次调用的方法、耗时和状态码
+func serverLog(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logs.Ctx(ctx).Info("grpc server",
+		zap.String("method", info.FullMethod),
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("cost", time.Since(start)),
+	)
+	return resp, err
+}
+
+// clientLog 记录调用失败的方法、耗时和错误
+func clientLog(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil {
+		logs.Ctx(ctx).Warn("grpc client",
+			zap.String("method", method),
+			zap.String("target", cc.Target()),
+			zap.Duration("cost", time.Since(start)),
+			zap.Error(err),
+		)
+	}
+	return err
+}
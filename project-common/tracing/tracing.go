@@ -0,0 +1,77 @@
+// Package tracing 提供基于 OpenTelemetry 的链路追踪。
+// 网关为每个请求生成 trace，通过 gRPC metadata（W3C traceparent）传递到用户服务和项目服务，
+// 日志和 Kafka 日志中记录 traceId，span 可以导出到本地的 OTLP collector 或标准输出。
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// 支持的导出方式
+const (
+	ExporterOtlp   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// Config 链路追踪的配置
+type Config struct {
+	Exporter    string  // otlp 导出到 OTLP collector，stdout 输出到标准输出，为空时只生成 traceId 不导出 span
+	Endpoint    string  // OTLP collector 的 gRPC 地址，如 localhost:4317
+	SampleRatio float64 // 导出 span 的采样比例，未采样的请求同样有 traceId
+}
+
+// Init 初始化全局的 TracerProvider 和 W3C traceparent 传播方式，返回关闭时刷新 span 的函数
+func Init(serviceName string, cfg *Config) (func(), error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+	switch cfg.Exporter {
+	case ExporterOtlp:
+		exporter, err := otlptracegrpc.New(context.Background(),
+			otlptracegrpc.WithEndpoint(cfg.Endpoint),
+			otlptracegrpc.WithInsecure(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	case ExporterStdout:
+		exporter, err := stdouttrace.New()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tp.Shutdown(ctx)
+	}, nil
+}
+
+// Tracer 返回项目使用的 Tracer
+func Tracer() trace.Tracer {
+	return otel.Tracer("ms_project")
+}
+
+// TraceId 返回上下文中的 traceId，没有 trace 时返回空字符串
+func TraceId(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
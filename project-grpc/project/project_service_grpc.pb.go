@@ -22,7 +22,17 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProjectServiceClient interface {
+	//*
+	// Index 方法用于获取系统菜单列表。
+	//
+	// @param IndexMessage 请求参数为空。
+	// @return IndexResponse 返回包含菜单列表的响应。
 	Index(ctx context.Context, in *IndexMessage, opts ...grpc.CallOption) (*IndexResponse, error)
+	//*
+	// FindProjectByMemId 方法用于根据成员ID查询其参与的项目列表。
+	//
+	// @param ProjectRpcMessage 请求参数包含成员ID、分页页码和每页记录数。
+	// @return MyProjectResponse 返回包含项目列表和总项目数的响应。
 	FindProjectByMemId(ctx context.Context, in *ProjectRpcMessage, opts ...grpc.CallOption) (*MyProjectResponse, error)
 	FindProjectTemplate(ctx context.Context, in *ProjectRpcMessage, opts ...grpc.CallOption) (*ProjectTemplateResponse, error)
 	SaveProject(ctx context.Context, in *ProjectRpcMessage, opts ...grpc.CallOption) (*SaveProjectMessage, error)
@@ -33,6 +43,8 @@ type ProjectServiceClient interface {
 	GetLogBySelfProject(ctx context.Context, in *ProjectRpcMessage, opts ...grpc.CallOption) (*ProjectLogResponse, error)
 	NodeList(ctx context.Context, in *ProjectRpcMessage, opts ...grpc.CallOption) (*ProjectNodeResponseMessage, error)
 	FindProjectByMemberId(ctx context.Context, in *ProjectRpcMessage, opts ...grpc.CallOption) (*FindProjectByMemberIdResponse, error)
+	// 修改项目成员的角色，只有项目的拥有者和管理员可以修改，拥有者的角色不能修改
+	UpdateProjectMemberRole(ctx context.Context, in *ProjectMemberRoleMessage, opts ...grpc.CallOption) (*ProjectMemberRoleResponse, error)
 }
 
 type projectServiceClient struct {
@@ -142,11 +154,30 @@ func (c *projectServiceClient) FindProjectByMemberId(ctx context.Context, in *Pr
 	return out, nil
 }
 
+func (c *projectServiceClient) UpdateProjectMemberRole(ctx context.Context, in *ProjectMemberRoleMessage, opts ...grpc.CallOption) (*ProjectMemberRoleResponse, error) {
+	out := new(ProjectMemberRoleResponse)
+	err := c.cc.Invoke(ctx, "/project.service.v1.ProjectService/UpdateProjectMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility
 type ProjectServiceServer interface {
+	//*
+	// Index 方法用于获取系统菜单列表。
+	//
+	// @param IndexMessage 请求参数为空。
+	// @return IndexResponse 返回包含菜单列表的响应。
 	Index(context.Context, *IndexMessage) (*IndexResponse, error)
+	//*
+	// FindProjectByMemId 方法用于根据成员ID查询其参与的项目列表。
+	//
+	// @param ProjectRpcMessage 请求参数包含成员ID、分页页码和每页记录数。
+	// @return MyProjectResponse 返回包含项目列表和总项目数的响应。
 	FindProjectByMemId(context.Context, *ProjectRpcMessage) (*MyProjectResponse, error)
 	FindProjectTemplate(context.Context, *ProjectRpcMessage) (*ProjectTemplateResponse, error)
 	SaveProject(context.Context, *ProjectRpcMessage) (*SaveProjectMessage, error)
@@ -157,6 +188,8 @@ type ProjectServiceServer interface {
 	GetLogBySelfProject(context.Context, *ProjectRpcMessage) (*ProjectLogResponse, error)
 	NodeList(context.Context, *ProjectRpcMessage) (*ProjectNodeResponseMessage, error)
 	FindProjectByMemberId(context.Context, *ProjectRpcMessage) (*FindProjectByMemberIdResponse, error)
+	// 修改项目成员的角色，只有项目的拥有者和管理员可以修改，拥有者的角色不能修改
+	UpdateProjectMemberRole(context.Context, *ProjectMemberRoleMessage) (*ProjectMemberRoleResponse, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -197,6 +230,9 @@ func (UnimplementedProjectServiceServer) NodeList(context.Context, *ProjectRpcMe
 func (UnimplementedProjectServiceServer) FindProjectByMemberId(context.Context, *ProjectRpcMessage) (*FindProjectByMemberIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindProjectByMemberId not implemented")
 }
+func (UnimplementedProjectServiceServer) UpdateProjectMemberRole(context.Context, *ProjectMemberRoleMessage) (*ProjectMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectMemberRole not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 
 // UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -408,6 +444,24 @@ func _ProjectService_FindProjectByMemberId_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_UpdateProjectMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectMemberRoleMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProjectMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.service.v1.ProjectService/UpdateProjectMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProjectMemberRole(ctx, req.(*ProjectMemberRoleMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -459,6 +513,10 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindProjectByMemberId",
 			Handler:    _ProjectService_FindProjectByMemberId_Handler,
 		},
+		{
+			MethodName: "UpdateProjectMemberRole",
+			Handler:    _ProjectService_UpdateProjectMemberRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "project_service.proto",
@@ -22,13 +22,76 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LoginServiceClient interface {
+	// GetCaptcha 获取验证码
 	GetCaptcha(ctx context.Context, in *CaptchaMessage, opts ...grpc.CallOption) (*CaptchaResponse, error)
+	// Register 用户注册
 	Register(ctx context.Context, in *RegisterMessage, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// Login 用户登录
 	Login(ctx context.Context, in *LoginMessage, opts ...grpc.CallOption) (*LoginResponse, error)
+	// TokenVerify 令牌验证
 	TokenVerify(ctx context.Context, in *LoginMessage, opts ...grpc.CallOption) (*LoginResponse, error)
+	// MyOrgList 获取我的组织列表
 	MyOrgList(ctx context.Context, in *UserMessage, opts ...grpc.CallOption) (*OrgListResponse, error)
+	// FindMemInfoById 根据用户ID获取用户信息
 	FindMemInfoById(ctx context.Context, in *UserMessage, opts ...grpc.CallOption) (*MemberMessage, error)
+	// FindMemInfoByIds 根据用户ID列表获取用户信息
 	FindMemInfoByIds(ctx context.Context, in *UserMessage, opts ...grpc.CallOption) (*MemberMessageList, error)
+	// RefreshToken 使用刷新令牌换取新的令牌对
+	RefreshToken(ctx context.Context, in *RefreshTokenMessage, opts ...grpc.CallOption) (*TokenMessage, error)
+	// Logout 退出当前登录
+	Logout(ctx context.Context, in *LogoutMessage, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// LogoutAll 退出所有设备上的登录
+	LogoutAll(ctx context.Context, in *LogoutMessage, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// SendResetCode 发送找回密码的验证码
+	SendResetCode(ctx context.Context, in *ResetCodeMessage, opts ...grpc.CallOption) (*CaptchaResponse, error)
+	// ResetPassword 使用验证码重置密码
+	ResetPassword(ctx context.Context, in *ResetPasswordMessage, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	// MfaEnroll 开始开启两步验证
+	MfaEnroll(ctx context.Context, in *MfaMessage, opts ...grpc.CallOption) (*MfaEnrollResponse, error)
+	// MfaConfirm 确认开启两步验证
+	MfaConfirm(ctx context.Context, in *MfaMessage, opts ...grpc.CallOption) (*MfaConfirmResponse, error)
+	// MfaDisable 关闭两步验证
+	MfaDisable(ctx context.Context, in *MfaMessage, opts ...grpc.CallOption) (*MfaDisableResponse, error)
+	// LoginMfa 两步验证登录
+	LoginMfa(ctx context.Context, in *LoginMfaMessage, opts ...grpc.CallOption) (*LoginResponse, error)
+	// CreateAccessToken 创建个人访问令牌
+	CreateAccessToken(ctx context.Context, in *AccessTokenMessage, opts ...grpc.CallOption) (*AccessTokenResponse, error)
+	// ListAccessTokens 查询个人访问令牌列表
+	ListAccessTokens(ctx context.Context, in *UserMessage, opts ...grpc.CallOption) (*AccessTokenList, error)
+	// RevokeAccessToken 吊销个人访问令牌
+	RevokeAccessToken(ctx context.Context, in *AccessTokenMessage, opts ...grpc.CallOption) (*RevokeAccessTokenResponse, error)
+	// UnlockMember 管理员解除成员账号的登录锁定
+	UnlockMember(ctx context.Context, in *UnlockMemberMessage, opts ...grpc.CallOption) (*UnlockMemberResponse, error)
+	// SwitchOrganization 切换当前组织，返回携带新组织的令牌
+	SwitchOrganization(ctx context.Context, in *SwitchOrganizationMessage, opts ...grpc.CallOption) (*LoginResponse, error)
+	// CreateOrganization 创建团队组织
+	CreateOrganization(ctx context.Context, in *CreateOrganizationMessage, opts ...grpc.CallOption) (*OrganizationMessage, error)
+	// CreateOrgInvite 生成组织邀请码
+	CreateOrgInvite(ctx context.Context, in *OrgInviteMessage, opts ...grpc.CallOption) (*OrgInviteResponse, error)
+	// AcceptOrgInvite 使用邀请码加入组织
+	AcceptOrgInvite(ctx context.Context, in *OrgInviteMessage, opts ...grpc.CallOption) (*OrganizationMessage, error)
+	// RemoveOrgMember 移除组织成员
+	RemoveOrgMember(ctx context.Context, in *OrgMemberMessage, opts ...grpc.CallOption) (*OrgMemberResponse, error)
+	// TransferOrganization 转让组织
+	TransferOrganization(ctx context.Context, in *OrgMemberMessage, opts ...grpc.CallOption) (*OrgMemberResponse, error)
+	// UpdateProfile 修改个人资料
+	UpdateProfile(ctx context.Context, in *ProfileMessage, opts ...grpc.CallOption) (*MemberMessage, error)
+	// ChangePassword 修改密码，需要提供原密码
+	ChangePassword(ctx context.Context, in *ChangePasswordMessage, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// SendChangeCode 向新的手机号或邮箱发送验证码
+	SendChangeCode(ctx context.Context, in *ChangeContactMessage, opts ...grpc.CallOption) (*CaptchaResponse, error)
+	// ChangeMobile 使用验证码修改手机号
+	ChangeMobile(ctx context.Context, in *ChangeContactMessage, opts ...grpc.CallOption) (*MemberMessage, error)
+	// ChangeEmail 使用验证码修改邮箱
+	ChangeEmail(ctx context.Context, in *ChangeContactMessage, opts ...grpc.CallOption) (*MemberMessage, error)
+	// LoginHistory 查询我的登录记录
+	LoginHistory(ctx context.Context, in *LoginLogMessage, opts ...grpc.CallOption) (*LoginLogResponse, error)
+	// ActiveSessions 查询我的活跃会话
+	ActiveSessions(ctx context.Context, in *LoginLogMessage, opts ...grpc.CallOption) (*SessionListResponse, error)
+	// RevokeSession 吊销一个会话，该会话的令牌立即失效
+	RevokeSession(ctx context.Context, in *LoginLogMessage, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// Jwks 获取校验访问令牌的公钥
+	Jwks(ctx context.Context, in *JwksMessage, opts ...grpc.CallOption) (*JwksResponse, error)
 }
 
 type loginServiceClient struct {
@@ -102,17 +165,332 @@ func (c *loginServiceClient) FindMemInfoByIds(ctx context.Context, in *UserMessa
 	return out, nil
 }
 
+func (c *loginServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenMessage, opts ...grpc.CallOption) (*TokenMessage, error) {
+	out := new(TokenMessage)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) Logout(ctx context.Context, in *LogoutMessage, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) LogoutAll(ctx context.Context, in *LogoutMessage, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/LogoutAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) SendResetCode(ctx context.Context, in *ResetCodeMessage, opts ...grpc.CallOption) (*CaptchaResponse, error) {
+	out := new(CaptchaResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/SendResetCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordMessage, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) MfaEnroll(ctx context.Context, in *MfaMessage, opts ...grpc.CallOption) (*MfaEnrollResponse, error) {
+	out := new(MfaEnrollResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/MfaEnroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) MfaConfirm(ctx context.Context, in *MfaMessage, opts ...grpc.CallOption) (*MfaConfirmResponse, error) {
+	out := new(MfaConfirmResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/MfaConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) MfaDisable(ctx context.Context, in *MfaMessage, opts ...grpc.CallOption) (*MfaDisableResponse, error) {
+	out := new(MfaDisableResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/MfaDisable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) LoginMfa(ctx context.Context, in *LoginMfaMessage, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/LoginMfa", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) CreateAccessToken(ctx context.Context, in *AccessTokenMessage, opts ...grpc.CallOption) (*AccessTokenResponse, error) {
+	out := new(AccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/CreateAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) ListAccessTokens(ctx context.Context, in *UserMessage, opts ...grpc.CallOption) (*AccessTokenList, error) {
+	out := new(AccessTokenList)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/ListAccessTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) RevokeAccessToken(ctx context.Context, in *AccessTokenMessage, opts ...grpc.CallOption) (*RevokeAccessTokenResponse, error) {
+	out := new(RevokeAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/RevokeAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) UnlockMember(ctx context.Context, in *UnlockMemberMessage, opts ...grpc.CallOption) (*UnlockMemberResponse, error) {
+	out := new(UnlockMemberResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/UnlockMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) SwitchOrganization(ctx context.Context, in *SwitchOrganizationMessage, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/SwitchOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationMessage, opts ...grpc.CallOption) (*OrganizationMessage, error) {
+	out := new(OrganizationMessage)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/CreateOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) CreateOrgInvite(ctx context.Context, in *OrgInviteMessage, opts ...grpc.CallOption) (*OrgInviteResponse, error) {
+	out := new(OrgInviteResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/CreateOrgInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) AcceptOrgInvite(ctx context.Context, in *OrgInviteMessage, opts ...grpc.CallOption) (*OrganizationMessage, error) {
+	out := new(OrganizationMessage)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/AcceptOrgInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) RemoveOrgMember(ctx context.Context, in *OrgMemberMessage, opts ...grpc.CallOption) (*OrgMemberResponse, error) {
+	out := new(OrgMemberResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/RemoveOrgMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) TransferOrganization(ctx context.Context, in *OrgMemberMessage, opts ...grpc.CallOption) (*OrgMemberResponse, error) {
+	out := new(OrgMemberResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/TransferOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) UpdateProfile(ctx context.Context, in *ProfileMessage, opts ...grpc.CallOption) (*MemberMessage, error) {
+	out := new(MemberMessage)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordMessage, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) SendChangeCode(ctx context.Context, in *ChangeContactMessage, opts ...grpc.CallOption) (*CaptchaResponse, error) {
+	out := new(CaptchaResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/SendChangeCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) ChangeMobile(ctx context.Context, in *ChangeContactMessage, opts ...grpc.CallOption) (*MemberMessage, error) {
+	out := new(MemberMessage)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/ChangeMobile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) ChangeEmail(ctx context.Context, in *ChangeContactMessage, opts ...grpc.CallOption) (*MemberMessage, error) {
+	out := new(MemberMessage)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/ChangeEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) LoginHistory(ctx context.Context, in *LoginLogMessage, opts ...grpc.CallOption) (*LoginLogResponse, error) {
+	out := new(LoginLogResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/LoginHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) ActiveSessions(ctx context.Context, in *LoginLogMessage, opts ...grpc.CallOption) (*SessionListResponse, error) {
+	out := new(SessionListResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/ActiveSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) RevokeSession(ctx context.Context, in *LoginLogMessage, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loginServiceClient) Jwks(ctx context.Context, in *JwksMessage, opts ...grpc.CallOption) (*JwksResponse, error) {
+	out := new(JwksResponse)
+	err := c.cc.Invoke(ctx, "/login.service.v1.LoginService/Jwks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoginServiceServer is the server API for LoginService service.
 // All implementations must embed UnimplementedLoginServiceServer
 // for forward compatibility
 type LoginServiceServer interface {
+	// GetCaptcha 获取验证码
 	GetCaptcha(context.Context, *CaptchaMessage) (*CaptchaResponse, error)
+	// Register 用户注册
 	Register(context.Context, *RegisterMessage) (*RegisterResponse, error)
+	// Login 用户登录
 	Login(context.Context, *LoginMessage) (*LoginResponse, error)
+	// TokenVerify 令牌验证
 	TokenVerify(context.Context, *LoginMessage) (*LoginResponse, error)
+	// MyOrgList 获取我的组织列表
 	MyOrgList(context.Context, *UserMessage) (*OrgListResponse, error)
+	// FindMemInfoById 根据用户ID获取用户信息
 	FindMemInfoById(context.Context, *UserMessage) (*MemberMessage, error)
+	// FindMemInfoByIds 根据用户ID列表获取用户信息
 	FindMemInfoByIds(context.Context, *UserMessage) (*MemberMessageList, error)
+	// RefreshToken 使用刷新令牌换取新的令牌对
+	RefreshToken(context.Context, *RefreshTokenMessage) (*TokenMessage, error)
+	// Logout 退出当前登录
+	Logout(context.Context, *LogoutMessage) (*LogoutResponse, error)
+	// LogoutAll 退出所有设备上的登录
+	LogoutAll(context.Context, *LogoutMessage) (*LogoutResponse, error)
+	// SendResetCode 发送找回密码的验证码
+	SendResetCode(context.Context, *ResetCodeMessage) (*CaptchaResponse, error)
+	// ResetPassword 使用验证码重置密码
+	ResetPassword(context.Context, *ResetPasswordMessage) (*ResetPasswordResponse, error)
+	// MfaEnroll 开始开启两步验证
+	MfaEnroll(context.Context, *MfaMessage) (*MfaEnrollResponse, error)
+	// MfaConfirm 确认开启两步验证
+	MfaConfirm(context.Context, *MfaMessage) (*MfaConfirmResponse, error)
+	// MfaDisable 关闭两步验证
+	MfaDisable(context.Context, *MfaMessage) (*MfaDisableResponse, error)
+	// LoginMfa 两步验证登录
+	LoginMfa(context.Context, *LoginMfaMessage) (*LoginResponse, error)
+	// CreateAccessToken 创建个人访问令牌
+	CreateAccessToken(context.Context, *AccessTokenMessage) (*AccessTokenResponse, error)
+	// ListAccessTokens 查询个人访问令牌列表
+	ListAccessTokens(context.Context, *UserMessage) (*AccessTokenList, error)
+	// RevokeAccessToken 吊销个人访问令牌
+	RevokeAccessToken(context.Context, *AccessTokenMessage) (*RevokeAccessTokenResponse, error)
+	// UnlockMember 管理员解除成员账号的登录锁定
+	UnlockMember(context.Context, *UnlockMemberMessage) (*UnlockMemberResponse, error)
+	// SwitchOrganization 切换当前组织，返回携带新组织的令牌
+	SwitchOrganization(context.Context, *SwitchOrganizationMessage) (*LoginResponse, error)
+	// CreateOrganization 创建团队组织
+	CreateOrganization(context.Context, *CreateOrganizationMessage) (*OrganizationMessage, error)
+	// CreateOrgInvite 生成组织邀请码
+	CreateOrgInvite(context.Context, *OrgInviteMessage) (*OrgInviteResponse, error)
+	// AcceptOrgInvite 使用邀请码加入组织
+	AcceptOrgInvite(context.Context, *OrgInviteMessage) (*OrganizationMessage, error)
+	// RemoveOrgMember 移除组织成员
+	RemoveOrgMember(context.Context, *OrgMemberMessage) (*OrgMemberResponse, error)
+	// TransferOrganization 转让组织
+	TransferOrganization(context.Context, *OrgMemberMessage) (*OrgMemberResponse, error)
+	// UpdateProfile 修改个人资料
+	UpdateProfile(context.Context, *ProfileMessage) (*MemberMessage, error)
+	// ChangePassword 修改密码，需要提供原密码
+	ChangePassword(context.Context, *ChangePasswordMessage) (*ChangePasswordResponse, error)
+	// SendChangeCode 向新的手机号或邮箱发送验证码
+	SendChangeCode(context.Context, *ChangeContactMessage) (*CaptchaResponse, error)
+	// ChangeMobile 使用验证码修改手机号
+	ChangeMobile(context.Context, *ChangeContactMessage) (*MemberMessage, error)
+	// ChangeEmail 使用验证码修改邮箱
+	ChangeEmail(context.Context, *ChangeContactMessage) (*MemberMessage, error)
+	// LoginHistory 查询我的登录记录
+	LoginHistory(context.Context, *LoginLogMessage) (*LoginLogResponse, error)
+	// ActiveSessions 查询我的活跃会话
+	ActiveSessions(context.Context, *LoginLogMessage) (*SessionListResponse, error)
+	// RevokeSession 吊销一个会话，该会话的令牌立即失效
+	RevokeSession(context.Context, *LoginLogMessage) (*RevokeSessionResponse, error)
+	// Jwks 获取校验访问令牌的公钥
+	Jwks(context.Context, *JwksMessage) (*JwksResponse, error)
 	mustEmbedUnimplementedLoginServiceServer()
 }
 
@@ -141,6 +519,90 @@ func (UnimplementedLoginServiceServer) FindMemInfoById(context.Context, *UserMes
 func (UnimplementedLoginServiceServer) FindMemInfoByIds(context.Context, *UserMessage) (*MemberMessageList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindMemInfoByIds not implemented")
 }
+func (UnimplementedLoginServiceServer) RefreshToken(context.Context, *RefreshTokenMessage) (*TokenMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedLoginServiceServer) Logout(context.Context, *LogoutMessage) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedLoginServiceServer) LogoutAll(context.Context, *LogoutMessage) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAll not implemented")
+}
+func (UnimplementedLoginServiceServer) SendResetCode(context.Context, *ResetCodeMessage) (*CaptchaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendResetCode not implemented")
+}
+func (UnimplementedLoginServiceServer) ResetPassword(context.Context, *ResetPasswordMessage) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedLoginServiceServer) MfaEnroll(context.Context, *MfaMessage) (*MfaEnrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MfaEnroll not implemented")
+}
+func (UnimplementedLoginServiceServer) MfaConfirm(context.Context, *MfaMessage) (*MfaConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MfaConfirm not implemented")
+}
+func (UnimplementedLoginServiceServer) MfaDisable(context.Context, *MfaMessage) (*MfaDisableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MfaDisable not implemented")
+}
+func (UnimplementedLoginServiceServer) LoginMfa(context.Context, *LoginMfaMessage) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginMfa not implemented")
+}
+func (UnimplementedLoginServiceServer) CreateAccessToken(context.Context, *AccessTokenMessage) (*AccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedLoginServiceServer) ListAccessTokens(context.Context, *UserMessage) (*AccessTokenList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessTokens not implemented")
+}
+func (UnimplementedLoginServiceServer) RevokeAccessToken(context.Context, *AccessTokenMessage) (*RevokeAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
+func (UnimplementedLoginServiceServer) UnlockMember(context.Context, *UnlockMemberMessage) (*UnlockMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockMember not implemented")
+}
+func (UnimplementedLoginServiceServer) SwitchOrganization(context.Context, *SwitchOrganizationMessage) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchOrganization not implemented")
+}
+func (UnimplementedLoginServiceServer) CreateOrganization(context.Context, *CreateOrganizationMessage) (*OrganizationMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedLoginServiceServer) CreateOrgInvite(context.Context, *OrgInviteMessage) (*OrgInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrgInvite not implemented")
+}
+func (UnimplementedLoginServiceServer) AcceptOrgInvite(context.Context, *OrgInviteMessage) (*OrganizationMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrgInvite not implemented")
+}
+func (UnimplementedLoginServiceServer) RemoveOrgMember(context.Context, *OrgMemberMessage) (*OrgMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrgMember not implemented")
+}
+func (UnimplementedLoginServiceServer) TransferOrganization(context.Context, *OrgMemberMessage) (*OrgMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOrganization not implemented")
+}
+func (UnimplementedLoginServiceServer) UpdateProfile(context.Context, *ProfileMessage) (*MemberMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedLoginServiceServer) ChangePassword(context.Context, *ChangePasswordMessage) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedLoginServiceServer) SendChangeCode(context.Context, *ChangeContactMessage) (*CaptchaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChangeCode not implemented")
+}
+func (UnimplementedLoginServiceServer) ChangeMobile(context.Context, *ChangeContactMessage) (*MemberMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMobile not implemented")
+}
+func (UnimplementedLoginServiceServer) ChangeEmail(context.Context, *ChangeContactMessage) (*MemberMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (UnimplementedLoginServiceServer) LoginHistory(context.Context, *LoginLogMessage) (*LoginLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginHistory not implemented")
+}
+func (UnimplementedLoginServiceServer) ActiveSessions(context.Context, *LoginLogMessage) (*SessionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveSessions not implemented")
+}
+func (UnimplementedLoginServiceServer) RevokeSession(context.Context, *LoginLogMessage) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedLoginServiceServer) Jwks(context.Context, *JwksMessage) (*JwksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Jwks not implemented")
+}
 func (UnimplementedLoginServiceServer) mustEmbedUnimplementedLoginServiceServer() {}
 
 // UnsafeLoginServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -280,40 +742,656 @@ func _LoginService_FindMemInfoByIds_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-// LoginService_ServiceDesc is the grpc.ServiceDesc for LoginService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var LoginService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "login.service.v1.LoginService",
-	HandlerType: (*LoginServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetCaptcha",
-			Handler:    _LoginService_GetCaptcha_Handler,
-		},
-		{
-			MethodName: "Register",
-			Handler:    _LoginService_Register_Handler,
-		},
-		{
-			MethodName: "Login",
-			Handler:    _LoginService_Login_Handler,
-		},
-		{
-			MethodName: "TokenVerify",
-			Handler:    _LoginService_TokenVerify_Handler,
-		},
-		{
-			MethodName: "MyOrgList",
-			Handler:    _LoginService_MyOrgList_Handler,
-		},
-		{
-			MethodName: "FindMemInfoById",
-			Handler:    _LoginService_FindMemInfoById_Handler,
-		},
-		{
-			MethodName: "FindMemInfoByIds",
-			Handler:    _LoginService_FindMemInfoByIds_Handler,
+func _LoginService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).RefreshToken(ctx, req.(*RefreshTokenMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).Logout(ctx, req.(*LogoutMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_LogoutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).LogoutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/LogoutAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).LogoutAll(ctx, req.(*LogoutMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_SendResetCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCodeMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).SendResetCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/SendResetCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).SendResetCode(ctx, req.(*ResetCodeMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).ResetPassword(ctx, req.(*ResetPasswordMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_MfaEnroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MfaMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).MfaEnroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/MfaEnroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).MfaEnroll(ctx, req.(*MfaMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_MfaConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MfaMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).MfaConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/MfaConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).MfaConfirm(ctx, req.(*MfaMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_MfaDisable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MfaMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).MfaDisable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/MfaDisable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).MfaDisable(ctx, req.(*MfaMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_LoginMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginMfaMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).LoginMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/LoginMfa",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).LoginMfa(ctx, req.(*LoginMfaMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessTokenMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).CreateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/CreateAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).CreateAccessToken(ctx, req.(*AccessTokenMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_ListAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).ListAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/ListAccessTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).ListAccessTokens(ctx, req.(*UserMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessTokenMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).RevokeAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/RevokeAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).RevokeAccessToken(ctx, req.(*AccessTokenMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_UnlockMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockMemberMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).UnlockMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/UnlockMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).UnlockMember(ctx, req.(*UnlockMemberMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_SwitchOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchOrganizationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).SwitchOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/SwitchOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).SwitchOrganization(ctx, req.(*SwitchOrganizationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/CreateOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_CreateOrgInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgInviteMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).CreateOrgInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/CreateOrgInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).CreateOrgInvite(ctx, req.(*OrgInviteMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_AcceptOrgInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgInviteMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).AcceptOrgInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/AcceptOrgInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).AcceptOrgInvite(ctx, req.(*OrgInviteMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_RemoveOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgMemberMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).RemoveOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/RemoveOrgMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).RemoveOrgMember(ctx, req.(*OrgMemberMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_TransferOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgMemberMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).TransferOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/TransferOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).TransferOrganization(ctx, req.(*OrgMemberMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).UpdateProfile(ctx, req.(*ProfileMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).ChangePassword(ctx, req.(*ChangePasswordMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_SendChangeCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeContactMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).SendChangeCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/SendChangeCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).SendChangeCode(ctx, req.(*ChangeContactMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_ChangeMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeContactMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).ChangeMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/ChangeMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).ChangeMobile(ctx, req.(*ChangeContactMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeContactMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/ChangeEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).ChangeEmail(ctx, req.(*ChangeContactMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_LoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginLogMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).LoginHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/LoginHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).LoginHistory(ctx, req.(*LoginLogMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_ActiveSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginLogMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).ActiveSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/ActiveSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).ActiveSessions(ctx, req.(*LoginLogMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginLogMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).RevokeSession(ctx, req.(*LoginLogMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoginService_Jwks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JwksMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).Jwks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/login.service.v1.LoginService/Jwks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).Jwks(ctx, req.(*JwksMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoginService_ServiceDesc is the grpc.ServiceDesc for LoginService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoginService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "login.service.v1.LoginService",
+	HandlerType: (*LoginServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCaptcha",
+			Handler:    _LoginService_GetCaptcha_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _LoginService_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _LoginService_Login_Handler,
+		},
+		{
+			MethodName: "TokenVerify",
+			Handler:    _LoginService_TokenVerify_Handler,
+		},
+		{
+			MethodName: "MyOrgList",
+			Handler:    _LoginService_MyOrgList_Handler,
+		},
+		{
+			MethodName: "FindMemInfoById",
+			Handler:    _LoginService_FindMemInfoById_Handler,
+		},
+		{
+			MethodName: "FindMemInfoByIds",
+			Handler:    _LoginService_FindMemInfoByIds_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _LoginService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _LoginService_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAll",
+			Handler:    _LoginService_LogoutAll_Handler,
+		},
+		{
+			MethodName: "SendResetCode",
+			Handler:    _LoginService_SendResetCode_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _LoginService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "MfaEnroll",
+			Handler:    _LoginService_MfaEnroll_Handler,
+		},
+		{
+			MethodName: "MfaConfirm",
+			Handler:    _LoginService_MfaConfirm_Handler,
+		},
+		{
+			MethodName: "MfaDisable",
+			Handler:    _LoginService_MfaDisable_Handler,
+		},
+		{
+			MethodName: "LoginMfa",
+			Handler:    _LoginService_LoginMfa_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _LoginService_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "ListAccessTokens",
+			Handler:    _LoginService_ListAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _LoginService_RevokeAccessToken_Handler,
+		},
+		{
+			MethodName: "UnlockMember",
+			Handler:    _LoginService_UnlockMember_Handler,
+		},
+		{
+			MethodName: "SwitchOrganization",
+			Handler:    _LoginService_SwitchOrganization_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _LoginService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "CreateOrgInvite",
+			Handler:    _LoginService_CreateOrgInvite_Handler,
+		},
+		{
+			MethodName: "AcceptOrgInvite",
+			Handler:    _LoginService_AcceptOrgInvite_Handler,
+		},
+		{
+			MethodName: "RemoveOrgMember",
+			Handler:    _LoginService_RemoveOrgMember_Handler,
+		},
+		{
+			MethodName: "TransferOrganization",
+			Handler:    _LoginService_TransferOrganization_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _LoginService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _LoginService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "SendChangeCode",
+			Handler:    _LoginService_SendChangeCode_Handler,
+		},
+		{
+			MethodName: "ChangeMobile",
+			Handler:    _LoginService_ChangeMobile_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _LoginService_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "LoginHistory",
+			Handler:    _LoginService_LoginHistory_Handler,
+		},
+		{
+			MethodName: "ActiveSessions",
+			Handler:    _LoginService_ActiveSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _LoginService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "Jwks",
+			Handler:    _LoginService_Jwks_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"project-common/logs"
+	"project-common/tracing"
 )
 
 // C 是配置的全局实例
@@ -19,6 +20,7 @@ type Config struct {
 	EtcdConfig  *EtcdConfig
 	MysqlConfig *MysqlConfig
 	JwtConfig   *JwtConfig
+	Trace       *tracing.Config
 }
 
 // ServerConfig 服务器配置
@@ -49,12 +51,10 @@ type MysqlConfig struct {
 	Db       string
 }
 
-// JwtConfig JWT配置
+// JwtConfig JWT配置，令牌由用户服务签发和校验，项目服务不保存签名密钥
 type JwtConfig struct {
-	AccessExp     int64
-	RefreshExp    int64
-	AccessSecret  string
-	RefreshSecret string
+	AccessExp  int64
+	RefreshExp int64
 }
 
 // InitConfig 初始化配置
@@ -75,6 +75,7 @@ func InitConfig() *Config {
 	conf.ReadEtcdConfig()
 	conf.InitMysqlConfig()
 	conf.InitJwtConfig()
+	conf.ReadTraceConfig()
 	return conf
 }
 
@@ -148,10 +149,18 @@ func (c *Config) InitMysqlConfig() {
 // InitJwtConfig 初始化JWT配置
 func (c *Config) InitJwtConfig() {
 	mc := &JwtConfig{
-		AccessSecret:  c.viper.GetString("jwt.accessSecret"),
-		AccessExp:     c.viper.GetInt64("jwt.accessExp"),
-		RefreshExp:    c.viper.GetInt64("jwt.refreshExp"),
-		RefreshSecret: c.viper.GetString("jwt.refreshSecret"),
+		AccessExp:  c.viper.GetInt64("jwt.accessExp"),
+		RefreshExp: c.viper.GetInt64("jwt.refreshExp"),
 	}
 	c.JwtConfig = mc
 }
+
+// ReadTraceConfig 读取链路追踪配置，exporter 为空时只生成 traceId 不导出 span
+func (c *Config) ReadTraceConfig() {
+	c.viper.SetDefault("trace.sampleRatio", 1)
+	c.Trace = &tracing.Config{
+		Exporter:    c.viper.GetString("trace.exporter"),
+		Endpoint:    c.viper.GetString("trace.endpoint"),
+		SampleRatio: c.viper.GetFloat64("trace.sampleRatio"),
+	}
+}
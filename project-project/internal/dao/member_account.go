@@ -33,6 +33,16 @@ func (m *MemberAccountDao) FindByMemberId(ctx context.Context, memberId int64) (
 	return
 }
 
+// FindByOrgAndMemberId 查询成员在指定组织中的账号
+func (m *MemberAccountDao) FindByOrgAndMemberId(ctx context.Context, organizationCode int64, memberId int64) (ma *data.MemberAccount, err error) {
+	session := m.conn.Session(ctx)
+	err = session.Where("organization_code=? and member_code=?", organizationCode, memberId).Take(&ma).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return
+}
+
 func NewMemberAccountDao() *MemberAccountDao {
 	return &MemberAccountDao{
 		conn: gorms.New(),
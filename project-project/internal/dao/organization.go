@@ -0,0 +1,28 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"project-project/internal/data"
+	"project-project/internal/database/gorms"
+)
+
+type OrganizationDao struct {
+	conn *gorms.GormConn
+}
+
+// FindOrganizationById 根据id查询组织
+func (o *OrganizationDao) FindOrganizationById(ctx context.Context, id int64) (org *data.Organization, err error) {
+	session := o.conn.Session(ctx)
+	err = session.Where("id=?", id).Take(&org).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return
+}
+
+func NewOrganizationDao() *OrganizationDao {
+	return &OrganizationDao{
+		conn: gorms.New(),
+	}
+}
@@ -66,7 +66,7 @@ func (p *ProjectDao) UpdateDeletedProject(ctx context.Context, code int64, delet
 func (p *ProjectDao) FindProjectByPIdAndMemId(ctx context.Context, projectCode int64, memberId int64) (*data.ProjectAndMember, error) {
 	var pms *data.ProjectAndMember
 	session := p.conn.Session(ctx)
-	sql := fmt.Sprintf("select a.*,b.project_code,b.member_code,b.join_time,b.is_owner,b.authorize from project a, project_member b where a.id = b.project_code and b.member_code=? and b.project_code=? limit 1")
+	sql := fmt.Sprintf("select a.*,b.project_code,b.member_code,b.join_time,b.is_owner,b.authorize,b.role from project a, project_member b where a.id = b.project_code and b.member_code=? and b.project_code=? limit 1")
 	raw := session.Raw(sql, memberId, projectCode)
 	err := raw.Scan(&pms).Error
 	return pms, err
@@ -86,6 +86,14 @@ func (p *ProjectDao) SaveProject(conn database.DbConn, ctx context.Context, pr *
 	return p.conn.Tx(ctx).Save(&pr).Error
 }
 
+// UpdateProjectMemberRole 修改成员在项目中的角色
+func (p *ProjectDao) UpdateProjectMemberRole(ctx context.Context, projectCode int64, memberCode int64, role string) error {
+	session := p.conn.Session(ctx)
+	return session.Model(&data.ProjectMember{}).
+		Where("project_code=? and member_code=?", projectCode, memberCode).
+		Update("role", role).Error
+}
+
 func (p *ProjectDao) SaveProjectMember(conn database.DbConn, ctx context.Context, pm *data.ProjectMember) error {
 	p.conn = conn.(*gorms.GormConn)
 	return p.conn.Tx(ctx).Save(&pm).Error
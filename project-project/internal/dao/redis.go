@@ -28,3 +28,8 @@ func (rc *RedisCache) Get(ctx context.Context, key string) (string, error) {
 	result, err := rc.rdb.Get(ctx, key).Result()
 	return result, err
 }
+
+// Publish 发布redis频道消息
+func (rc *RedisCache) Publish(ctx context.Context, channel string, message string) error {
+	return rc.rdb.Publish(ctx, channel, message).Err()
+}
@@ -0,0 +1,13 @@
+package data
+
+// Organization 组织，由用户服务维护，项目服务只读取组织的拥有者
+type Organization struct {
+	Id       int64
+	Name     string
+	MemberId int64 // 组织的拥有者
+	Personal int32
+}
+
+func (*Organization) TableName() string {
+	return "organization"
+}
@@ -54,12 +54,30 @@ type ProjectMember struct {
 	JoinTime    int64
 	IsOwner     int64
 	Authorize   string
+	Role        string
 }
 
 func (*ProjectMember) TableName() string {
 	return "project_member"
 }
 
+// ProjectRole 成员在项目中的角色
+func (pm *ProjectMember) ProjectRole() string {
+	return projectRole(pm.Role, pm.IsOwner, pm.MemberCode)
+}
+
+// projectRole 返回项目角色，没有保存角色的旧数据中拥有者为 owner，其他成员为 member。
+// is_owner 保存的是项目拥有者的成员id
+func projectRole(role string, isOwner int64, memberCode int64) string {
+	if role != "" {
+		return role
+	}
+	if isOwner == memberCode {
+		return model.ProjectRoleOwner
+	}
+	return model.ProjectRoleMember
+}
+
 type ProjectCollection struct {
 	Id          int64
 	ProjectCode int64
@@ -79,10 +97,16 @@ type ProjectAndMember struct {
 	JoinTime    int64
 	IsOwner     int64
 	Authorize   string
+	Role        string
 	OwnerName   string
 	Collected   int
 }
 
+// ProjectRole 成员在项目中的角色
+func (m *ProjectAndMember) ProjectRole() string {
+	return projectRole(m.Role, m.IsOwner, m.MemberCode)
+}
+
 func (m *ProjectAndMember) GetAccessControlType() string {
 	if m.AccessControlType == 0 {
 		return "open"
@@ -6,6 +6,7 @@ import (
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"project-common/metrics"
 	"project-project/config"
 )
 
@@ -26,6 +27,10 @@ func init() {
 	if err != nil {
 		panic("连接数据库失败, error=" + err.Error())
 	}
+	// 记录每次数据库操作的耗时
+	if err = _db.Use(metrics.GormPlugin{}); err != nil {
+		panic("注册数据库指标失败, error=" + err.Error())
+	}
 }
 
 func GetDB() *gorm.DB {
@@ -72,6 +72,18 @@ func (d *AccountDomain) FindAccount(memberId int64) (*data.MemberAccount, *errs.
 	return account, nil
 }
 
+// FindOrgAccount 查询成员在指定组织中的账号，organizationCode 为0时查询成员的任一账号
+func (d *AccountDomain) FindOrgAccount(organizationCode int64, memberId int64) (*data.MemberAccount, *errs.BError) {
+	if organizationCode == 0 {
+		return d.FindAccount(memberId)
+	}
+	account, err := d.accountRepo.FindByOrgAndMemberId(context.Background(), organizationCode, memberId)
+	if err != nil {
+		return nil, model.DBError
+	}
+	return account, nil
+}
+
 func NewAccountDomain() *AccountDomain {
 	return &AccountDomain{
 		accountRepo:      dao.NewMemberAccountDao(),
@@ -19,6 +19,7 @@ type ProjectAuthDomain struct {
 	projectNodeDomain     *ProjectNodeDomain
 	projectAuthNodeDomain *ProjectAuthNodeDomain
 	accountDomain         *AccountDomain
+	organizationRepo      repo.OrganizationRepo
 }
 
 // AuthList 查询权限列表
@@ -77,23 +78,37 @@ func (d *ProjectAuthDomain) Save(conn database.DbConn, authId int64, nodes []str
 	return nil
 }
 
-func (d *ProjectAuthDomain) AuthNodes(memberId int64) ([]string, *errs.BError) {
-	account, err := d.accountDomain.FindAccount(memberId)
+// AuthNodes 查询成员在组织中的角色拥有的权限节点，同时返回成员是否为组织的拥有者。
+// 拥有者以 organization.member_id 为准，注册时创建的个人组织没有 member_account 记录，拥有者没有角色的权限节点；
+// 成员既不是拥有者也没有该组织的账号时返回不是该组织的成员
+func (d *ProjectAuthDomain) AuthNodes(memberId int64, organizationCode int64) ([]string, bool, *errs.BError) {
+	account, err := d.accountDomain.FindOrgAccount(organizationCode, memberId)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
+	if account != nil && organizationCode == 0 {
+		organizationCode = account.OrganizationCode
+	}
+	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	org, dbErr := d.organizationRepo.FindOrganizationById(c, organizationCode)
+	if dbErr != nil {
+		zap.L().Error("project AuthNodes organizationRepo.FindOrganizationById error", zap.Error(dbErr))
+		return nil, false, model.DBError
+	}
+	isOwner := org != nil && org.MemberId == memberId
 	if account == nil {
-		return nil, model.ParamsError
+		if isOwner {
+			return []string{}, true, nil
+		}
+		return nil, false, model.NotOrgMember
 	}
-	//c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	//defer cancel()
-	authorize := account.Authorize
-	authId, _ := strconv.ParseInt(authorize, 10, 64)
+	authId, _ := strconv.ParseInt(account.Authorize, 10, 64)
 	authNodeList, dbErr := d.projectAuthNodeDomain.AuthNodeList(authId)
 	if dbErr != nil {
-		return nil, model.DBError
+		return nil, false, model.DBError
 	}
-	return authNodeList, nil
+	return authNodeList, isOwner, nil
 }
 
 func NewProjectAuthDomain() *ProjectAuthDomain {
@@ -103,5 +118,6 @@ func NewProjectAuthDomain() *ProjectAuthDomain {
 		projectNodeDomain:     NewProjectNodeDomain(),
 		projectAuthNodeDomain: NewProjectAuthNodeDomain(),
 		accountDomain:         NewAccountDomain(),
+		organizationRepo:      dao.NewOrganizationDao(),
 	}
 }
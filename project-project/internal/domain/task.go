@@ -21,14 +21,14 @@ func NewTaskDomain() *TaskDomain {
 	}
 }
 
-func (d *TaskDomain) FindProjectIdByTaskId(taskId int64) (int64, bool, *errs.BError) {
+func (d *TaskDomain) FindProjectIdByTaskId(ctx context.Context, taskId int64) (int64, bool, *errs.BError) {
 	fmt.Println("FindProjectIdByTaskId")
-	config.SendLog(kafka.Info("Find", "TaskDomain.FindProjectIdByTaskId", kafka.FieldMap{
+	config.SendLog(kafka.Info(ctx, "Find", "TaskDomain.FindProjectIdByTaskId", kafka.FieldMap{
 		"taskId": taskId,
 	}))
-	task, err := d.taskRepo.FindTaskById(context.Background(), taskId)
+	task, err := d.taskRepo.FindTaskById(ctx, taskId)
 	if err != nil {
-		config.SendLog(kafka.Error(err, "TaskDomain.FindProjectIdByTaskId.taskRepo.FindTaskById", kafka.FieldMap{
+		config.SendLog(kafka.Error(ctx, err, "TaskDomain.FindProjectIdByTaskId.taskRepo.FindTaskById", kafka.FieldMap{
 			"taskId": taskId,
 		}))
 		return 0, false, model.DBError
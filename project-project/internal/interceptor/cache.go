@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"project-common/encrypts"
+	"project-common/metrics"
 	"project-project/internal/dao"
 	"project-project/internal/repo"
 	"time"
@@ -57,12 +58,14 @@ func (c *CacheInterceptor) Cache() grpc.ServerOption {
 		respJson, _ := c.cache.Get(con, info.FullMethod+"::"+cacheKey)
 		if respJson != "" {
 			// 如果缓存存在，则解析并返回缓存结果
+			metrics.CacheHit(info.FullMethod)
 			json.Unmarshal([]byte(respJson), &respType)
 			zap.L().Info(info.FullMethod + " 使用了缓存")
 			return respType, nil
 		}
 
 		// 如果缓存不存在，则调用处理器并缓存结果
+		metrics.CacheMiss(info.FullMethod)
 		resp, err = handler(ctx, req)
 		bytes, _ := json.Marshal(resp)
 		c.cache.Put(con, info.FullMethod+"::"+cacheKey, string(bytes), 5*time.Minute)
@@ -8,4 +8,5 @@ import (
 type AccountRepo interface {
 	FindList(ctx context.Context, condition string, organizationCode int64, departmentCode int64, page int64, pageSize int64) ([]*data.MemberAccount, int64, error)
 	FindByMemberId(background context.Context, memberId int64) (*data.MemberAccount, error)
+	FindByOrgAndMemberId(ctx context.Context, organizationCode int64, memberId int64) (*data.MemberAccount, error)
 }
@@ -8,4 +8,6 @@ import (
 type Cache interface {
 	Put(ctx context.Context, key, value string, expire time.Duration) error
 	Get(ctx context.Context, key string) (string, error)
+	// Publish 向频道发布消息
+	Publish(ctx context.Context, channel string, message string) error
 }
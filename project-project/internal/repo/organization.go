@@ -0,0 +1,11 @@
+package repo
+
+import (
+	"context"
+	"project-project/internal/data"
+)
+
+type OrganizationRepo interface {
+	// FindOrganizationById 根据id查询组织，不存在时返回nil
+	FindOrganizationById(ctx context.Context, id int64) (*data.Organization, error)
+}
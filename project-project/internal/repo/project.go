@@ -15,6 +15,8 @@ type ProjectRepo interface {
 	SaveProject(conn database.DbConn, ctx context.Context, pr *data.Project) error
 	// SaveProjectMember 保存项目成员
 	SaveProjectMember(conn database.DbConn, ctx context.Context, pm *data.ProjectMember) error
+	// UpdateProjectMemberRole 修改成员在项目中的角色
+	UpdateProjectMemberRole(ctx context.Context, projectCode int64, memberCode int64, role string) error
 	// FindProjectByPIdAndMemId 根据项目id和成员id查询项目
 	FindProjectByPIdAndMemId(ctx context.Context, projectCode int64, memberId int64) (*data.ProjectAndMember, error)
 	// FindCollectByPidAndMemId 根据项目id和成员id查询项目收藏
@@ -5,19 +5,25 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/resolver"
 	"log"
-	"project-api/config"
 	"project-common/discovery"
 	"project-common/logs"
+	"project-common/tracing"
 	"project-grpc/user/login"
+	"project-project/config"
 )
 
 var LoginServiceClient login.LoginServiceClient
 
+// userRetryMethods 项目服务调用的用户服务只读方法，服务不可用时可以安全重试
+var userRetryMethods = discovery.RetryMethods{
+	"login.service.v1.LoginService": {"FindMemInfoById", "FindMemInfoByIds"},
+}
+
 func InitRpcUserClient() {
 	etcdRegister := discovery.NewResolver(config.C.EtcdConfig.Addrs, logs.LG)
 	resolver.Register(etcdRegister)
 
-	conn, err := grpc.Dial("127.0.0.1:8881", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := discovery.Dial("user", userRetryMethods, nil, append(tracing.DialOptions(), grpc.WithTransportCredentials(insecure.NewCredentials()))...)
 	if err != nil {
 		log.Fatalf("did not connect: %v", err)
 	}
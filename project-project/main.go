@@ -2,15 +2,25 @@ package main
 
 import (
 	"github.com/gin-gonic/gin"
+	"log"
 	srv "project-common"
+	"project-common/metrics"
+	"project-common/tracing"
 	"project-project/config"
 	"project-project/router"
 )
 
 func main() {
 	r := gin.Default()
+	// 初始化链路追踪
+	stopTrace, err := tracing.Init(config.C.SC.Name, config.C.Trace)
+	if err != nil {
+		log.Fatalln(err)
+	}
 	//路由
 	router.InitRouter(r)
+	// Prometheus 指标
+	r.GET("/metrics", metrics.Handler())
 	//grpc服务注册
 	gc := router.RegisterGrpc()
 	//grpc服务注册到etcd
@@ -20,6 +30,7 @@ func main() {
 	stop := func() {
 		gc.Stop()
 		c()
+		stopTrace()
 	}
 	//初始化rpc调用
 	router.InitUserRpc()
@@ -38,6 +38,14 @@ const (
 	Owner
 )
 
+// 项目角色，权限从高到低依次为拥有者、管理员、成员、只读访客
+const (
+	ProjectRoleOwner  = "owner"
+	ProjectRoleAdmin  = "admin"
+	ProjectRoleMember = "member"
+	ProjectRoleViewer = "viewer"
+)
+
 const (
 	NoExecutor = iota
 	Executor
@@ -0,0 +1,21 @@
+package model
+
+// 看板事件的类型
+const (
+	TaskCreated = "task.created" // 新建任务
+	TaskUpdated = "task.updated" // 修改任务
+	TaskMoved   = "task.moved"   // 任务移动到其他阶段或调整了阶段内的顺序
+)
+
+// BoardEvent 看板变化事件，发布到 redis 的 BoardEventChannel 频道，由网关推送给订阅了该项目的客户端。
+// 私有任务只有任务成员可见，事件里只有编号，客户端收到后按编号重新查询任务或阶段的任务列表
+type BoardEvent struct {
+	Type          string `json:"type"`
+	ProjectCode   string `json:"projectCode"`
+	StageCode     string `json:"stageCode"`               // 任务当前所在的阶段
+	FromStageCode string `json:"fromStageCode,omitempty"` // 移动任务时，任务原来所在的阶段
+	TaskCode      string `json:"taskCode"`
+	NextTaskCode  string `json:"nextTaskCode,omitempty"` // 移动任务时，排在任务后面的任务，为空时任务排在阶段末尾
+	MemberCode    string `json:"memberCode"`             // 操作人
+	Time          int64  `json:"time"`                   // 毫秒时间戳
+}
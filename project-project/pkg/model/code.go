@@ -18,4 +18,9 @@ var (
 	TaskNameNotNull       = errs.NewError(20102001, "任务标题不能为空")
 	TaskStagesNotNull     = errs.NewError(20102002, "任务步骤不存在")
 	ProjectAlreadyDeleted = errs.NewError(20102003, "项目已经删除了")
+	ProjectRoleError      = errs.NewError(20102004, "项目角色不合法")
+	ProjectNoPermission   = errs.NewError(20102005, "无权修改该成员的项目角色")
+	NotProjectMember      = errs.NewError(20102006, "不是项目成员")
+	TaskNotExist          = errs.NewError(20102007, "任务不存在")
+	NotOrgMember          = errs.NewError(20102008, "不是该组织的成员")
 )
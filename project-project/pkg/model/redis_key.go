@@ -2,4 +2,8 @@ package model
 
 var (
 	RegisterRedisKey = "REGISTER_"
+	// BoardEventChannel 发布看板变化事件的频道，网关收到后推送给订阅了该项目的客户端
+	BoardEventChannel = "BOARD_EVENT"
+	// AuthGrantChannel 发布角色权限节点变化的频道，网关收到后丢弃接口权限校验的缓存
+	AuthGrantChannel = "AUTH_GRANT"
 )
@@ -3,14 +3,19 @@ package auth_service_v1
 import (
 	"context"
 	"github.com/jinzhu/copier"
+	"go.uber.org/zap"
 	"project-common/encrypts"
 	"project-common/errs"
+	"project-common/logs"
 	"project-grpc/auth"
 	"project-project/internal/dao"
 	"project-project/internal/database"
 	"project-project/internal/database/tran"
 	"project-project/internal/domain"
 	"project-project/internal/repo"
+	"project-project/pkg/model"
+	"strconv"
+	"time"
 )
 
 type AuthService struct {
@@ -65,14 +70,25 @@ func (a *AuthService) Apply(ctx context.Context, msg *auth.AuthReqMessage) (*aut
 		if err != nil {
 			return nil, errs.GrpcError(err.(*errs.BError))
 		}
+		a.publishAuthGrant(ctx, authId)
 	}
 	return &auth.ApplyResponse{}, nil
 }
 
+// publishAuthGrant 通知所有网关实例角色的权限节点已修改，发布失败只记录日志，网关的缓存最迟一分钟后过期
+func (a *AuthService) publishAuthGrant(ctx context.Context, authId int64) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
+	defer cancel()
+	if err := a.cache.Publish(ctx, model.AuthGrantChannel, strconv.FormatInt(authId, 10)); err != nil {
+		logs.Ctx(ctx).Error("project auth publishAuthGrant cache.Publish error", zap.Int64("authId", authId), zap.Error(err))
+	}
+}
+
 func (a *AuthService) AuthNodesByMemberId(ctx context.Context, msg *auth.AuthReqMessage) (*auth.AuthNodesResponse, error) {
-	list, err := a.projectAuthDomain.AuthNodes(msg.MemberId)
+	organizationCode := encrypts.DecryptNoErr(msg.OrganizationCode)
+	list, isOwner, err := a.projectAuthDomain.AuthNodes(msg.MemberId, organizationCode)
 	if err != nil {
 		return nil, errs.GrpcError(err)
 	}
-	return &auth.AuthNodesResponse{List: list}, nil
+	return &auth.AuthNodesResponse{List: list, IsOwner: isOwner}, nil
 }
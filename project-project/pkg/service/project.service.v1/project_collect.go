@@ -5,6 +5,7 @@ import (
 	"go.uber.org/zap"
 	"project-common/encrypts"
 	"project-common/errs"
+	"project-common/logs"
 	"project-grpc/project"
 	"project-project/internal/data"
 	"project-project/pkg/model"
@@ -29,7 +30,7 @@ func (ps *ProjectService) UpdateCollectProject(ctx context.Context, msg *project
 	// 将解密后的项目代码字符串转换为int64类型。
 	projectCode, _ := strconv.ParseInt(projectCodeStr, 10, 64)
 	// 创建一个带有超时的上下文，用于控制后续操作不超过2秒。
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel() // 确保在函数退出时取消上下文。
 
 	var err error
@@ -50,7 +51,7 @@ func (ps *ProjectService) UpdateCollectProject(ctx context.Context, msg *project
 	}
 	// 如果发生错误，记录日志并返回错误。
 	if err != nil {
-		zap.L().Error("project UpdateCollectProject SaveProjectCollect error", zap.Error(err))
+		logs.Ctx(ctx).Error("project UpdateCollectProject SaveProjectCollect error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	// 操作成功，返回空响应对象和nil错误。
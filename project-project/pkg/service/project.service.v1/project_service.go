@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 	"project-common/encrypts"
 	"project-common/errs"
+	"project-common/logs"
 	"project-common/tms"
 	"project-grpc/project"
 	"project-grpc/user/login"
@@ -58,12 +59,12 @@ func New() *ProjectService {
 
 // Index 获取项目的菜单列表
 // 该方法从数据库中检索菜单信息，并将其转换为菜单消息列表返回
-func (p *ProjectService) Index(context.Context, *project.IndexMessage) (*project.IndexResponse, error) {
+func (p *ProjectService) Index(ctx context.Context, msg *project.IndexMessage) (*project.IndexResponse, error) {
 	// 从数据库中获取菜单列表
-	pms, err := p.menuRepo.FindMenus(context.Background())
+	pms, err := p.menuRepo.FindMenus(ctx)
 	if err != nil {
 		// 如果发生错误，记录错误日志并返回数据库错误
-		zap.L().Error("Index db FindMenus error", zap.Error(err))
+		logs.Ctx(ctx).Error("Index db FindMenus error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	// 将获取的菜单信息转换为子菜单结构
@@ -107,7 +108,7 @@ func (p *ProjectService) FindProjectByMemId(ctx context.Context, msg *project.Pr
 	} else {
 		collectPms, _, err := p.projectRepo.FindCollectProjectByMemId(ctx, memberId, page, pageSize)
 		if err != nil {
-			zap.L().Error("project FindProjectByMemId::FindCollectProjectByMemId error", zap.Error(err))
+			logs.Ctx(ctx).Error("project FindProjectByMemId::FindCollectProjectByMemId error", zap.Error(err))
 			return nil, errs.GrpcError(model.DBError)
 		}
 		var cMap = make(map[int64]*data.ProjectAndMember)
@@ -121,7 +122,7 @@ func (p *ProjectService) FindProjectByMemId(ctx context.Context, msg *project.Pr
 		}
 	}
 	if err != nil {
-		zap.L().Error("project FindProjectByMemId error", zap.Error(err))
+		logs.Ctx(ctx).Error("project FindProjectByMemId error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if pms == nil {
@@ -169,14 +170,14 @@ func (ps *ProjectService) FindProjectTemplate(ctx context.Context, msg *project.
 		pts, total, err = ps.projectTemplateRepo.FindProjectTemplateSystem(ctx, page, pageSize)
 	}
 	if err != nil {
-		zap.L().Error("project FindProjectTemplate FindProjectTemplateSystem error", zap.Error(err))
+		logs.Ctx(ctx).Error("project FindProjectTemplate FindProjectTemplateSystem error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
 	// 根据项目模板ID查询任务阶段模板
 	tsts, err := ps.taskStagesTemplateRepo.FindInProTemIds(ctx, data.ToProjectTemplateIds(pts))
 	if err != nil {
-		zap.L().Error("project FindProjectTemplate FindInProTemIds error", zap.Error(err))
+		logs.Ctx(ctx).Error("project FindProjectTemplate FindInProTemIds error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
@@ -222,7 +223,7 @@ func (ps *ProjectService) SaveProject(ctx context.Context, msg *project.ProjectR
 		// 保存项目信息
 		err := ps.projectRepo.SaveProject(conn, ctx, pr)
 		if err != nil {
-			zap.L().Error("project SaveProject SaveProject error", zap.Error(err))
+			logs.Ctx(ctx).Error("project SaveProject SaveProject error", zap.Error(err))
 			return errs.GrpcError(model.DBError)
 		}
 		// 创建项目成员关联对象
@@ -232,11 +233,12 @@ func (ps *ProjectService) SaveProject(ctx context.Context, msg *project.ProjectR
 			JoinTime:    time.Now().UnixMilli(),
 			IsOwner:     msg.MemberId,
 			Authorize:   "",
+			Role:        model.ProjectRoleOwner,
 		}
 		//2. 保存项目和成员的关联表
 		err = ps.projectRepo.SaveProjectMember(conn, ctx, pm)
 		if err != nil {
-			zap.L().Error("project SaveProject SaveProjectMember error", zap.Error(err))
+			logs.Ctx(ctx).Error("project SaveProject SaveProjectMember error", zap.Error(err))
 			return errs.GrpcError(model.DBError)
 		}
 		return nil
@@ -274,13 +276,13 @@ func (ps *ProjectService) FindProjectDetail(ctx context.Context, msg *project.Pr
 	memberId := msg.MemberId
 
 	// 创建一个带有超时的上下文，以防止长时间运行的查询导致系统资源耗尽。
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// 查询项目和成员的详细信息。
 	projectAndMember, err := ps.projectRepo.FindProjectByPIdAndMemId(c, projectCode, memberId)
 	if err != nil {
-		zap.L().Error("project FindProjectDetail FindProjectByPIdAndMemId error", zap.Error(err))
+		logs.Ctx(ctx).Error("project FindProjectDetail FindProjectByPIdAndMemId error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
@@ -288,14 +290,14 @@ func (ps *ProjectService) FindProjectDetail(ctx context.Context, msg *project.Pr
 	ownerId := projectAndMember.IsOwner
 	member, err := rpc.LoginServiceClient.FindMemInfoById(c, &login.UserMessage{MemId: ownerId})
 	if err != nil {
-		zap.L().Error("project rpc FindProjectDetail FindMemInfoById error", zap.Error(err))
+		logs.Ctx(ctx).Error("project rpc FindProjectDetail FindMemInfoById error", zap.Error(err))
 		return nil, err
 	}
 
 	// 检查当前成员是否收藏了该项目。
 	isCollect, err := ps.projectRepo.FindCollectByPidAndMemId(c, projectCode, memberId)
 	if err != nil {
-		zap.L().Error("project FindProjectDetail FindCollectByPidAndMemId error", zap.Error(err))
+		logs.Ctx(ctx).Error("project FindProjectDetail FindCollectByPidAndMemId error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if isCollect {
@@ -332,13 +334,13 @@ func (ps *ProjectService) UpdateDeletedProject(ctx context.Context, msg *project
 	// 将解密后的项目代码字符串转换为整数类型。
 	projectCode, _ := strconv.ParseInt(projectCodeStr, 10, 64)
 	// 创建一个带有超时的context，以确保数据库操作不会无限期地等待。
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	// 调用项目仓库的UpdateDeletedProject方法更新数据库中的项目删除状态。
 	err := ps.projectRepo.UpdateDeletedProject(c, projectCode, msg.Deleted)
 	if err != nil {
 		// 如果更新失败，记录错误日志并返回一个gRPC错误。
-		zap.L().Error("project RecycleProject DeleteProject error", zap.Error(err))
+		logs.Ctx(ctx).Error("project RecycleProject DeleteProject error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	// 如果更新成功，返回一个空的DeletedProjectResponse对象。
@@ -355,7 +357,7 @@ func (ps *ProjectService) UpdateProject(ctx context.Context, msg *project.Update
 	// 将解密后的项目代码解析为int64类型
 	projectCode, _ := strconv.ParseInt(projectCodeStr, 10, 64)
 	// 创建一个带有超时的context，以防止更新操作长时间运行
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	// 创建一个Project实例，填充从消息中获取的更新信息
 	proj := &data.Project{
@@ -376,7 +378,7 @@ func (ps *ProjectService) UpdateProject(ctx context.Context, msg *project.Update
 	err := ps.projectRepo.UpdateProject(c, proj)
 	if err != nil {
 		// 如果更新过程中发生错误，记录错误日志并返回一个gRPC错误
-		zap.L().Error("project UpdateProject::UpdateProject error", zap.Error(err))
+		logs.Ctx(ctx).Error("project UpdateProject::UpdateProject error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	// 返回一个空的更新项目响应，表示更新操作成功
@@ -386,9 +388,9 @@ func (ps *ProjectService) UpdateProject(ctx context.Context, msg *project.Update
 func (ps *ProjectService) GetLogBySelfProject(ctx context.Context, msg *project.ProjectRpcMessage) (*project.ProjectLogResponse, error) {
 	//根据用户id查询当前的用户的日志表
 
-	projectLogs, total, err := ps.projectLogRepo.FindLogByMemberCode(context.Background(), msg.MemberId, msg.Page, msg.PageSize)
+	projectLogs, total, err := ps.projectLogRepo.FindLogByMemberCode(ctx, msg.MemberId, msg.Page, msg.PageSize)
 	if err != nil {
-		zap.L().Error("project ProjectService::GetLogBySelfProject projectLogRepo.FindLogByMemberCode error", zap.Error(err))
+		logs.Ctx(ctx).Error("project ProjectService::GetLogBySelfProject projectLogRepo.FindLogByMemberCode error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	//查询项目信息
@@ -400,23 +402,23 @@ func (ps *ProjectService) GetLogBySelfProject(ctx context.Context, msg *project.
 		mIdList = append(mIdList, v.MemberCode)
 		taskIdList = append(taskIdList, v.SourceCode)
 	}
-	projects, err := ps.projectRepo.FindProjectByIds(context.Background(), pIdList)
+	projects, err := ps.projectRepo.FindProjectByIds(ctx, pIdList)
 	if err != nil {
-		zap.L().Error("project ProjectService::GetLogBySelfProject projectLogRepo.FindProjectByIds error", zap.Error(err))
+		logs.Ctx(ctx).Error("project ProjectService::GetLogBySelfProject projectLogRepo.FindProjectByIds error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	pMap := make(map[int64]*data.Project)
 	for _, v := range projects {
 		pMap[v.Id] = v
 	}
-	messageList, _ := rpc.LoginServiceClient.FindMemInfoByIds(context.Background(), &login.UserMessage{MIds: mIdList})
+	messageList, _ := rpc.LoginServiceClient.FindMemInfoByIds(ctx, &login.UserMessage{MIds: mIdList})
 	mMap := make(map[int64]*login.MemberMessage)
 	for _, v := range messageList.List {
 		mMap[v.Id] = v
 	}
-	tasks, err := ps.taskRepo.FindTaskByIds(context.Background(), taskIdList)
+	tasks, err := ps.taskRepo.FindTaskByIds(ctx, taskIdList)
 	if err != nil {
-		zap.L().Error("project ProjectService::GetLogBySelfProject projectLogRepo.FindTaskByIds error", zap.Error(err))
+		logs.Ctx(ctx).Error("project ProjectService::GetLogBySelfProject projectLogRepo.FindTaskByIds error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	tMap := make(map[int64]*data.Task)
@@ -437,60 +439,109 @@ func (ps *ProjectService) GetLogBySelfProject(ctx context.Context, msg *project.
 	return &project.ProjectLogResponse{List: msgList, Total: total}, nil
 }
 
+// FindProjectByMemberId 按请求中的项目、任务和任务阶段查询成员所在的项目。
+// 请求中出现的每个参数都会被解析，它们必须属于同一个项目；任何一个找不到或者属于不同的项目时都按不是项目成员处理。
 func (ps *ProjectService) FindProjectByMemberId(ctx context.Context, msg *project.ProjectRpcMessage) (*project.FindProjectByMemberIdResponse, error) {
-	isProjectCode := false
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
 	var projectId int64
+	resolved := false
+	// sameProject 记录解析出的项目，与之前解析出的项目不一致时返回 false
+	sameProject := func(id int64) bool {
+		if resolved && id != projectId {
+			return false
+		}
+		projectId, resolved = id, true
+		return true
+	}
 	if msg.ProjectCode != "" {
-		projectId = encrypts.DecryptNoErr(msg.ProjectCode)
-		isProjectCode = true
+		sameProject(encrypts.DecryptNoErr(msg.ProjectCode))
 	}
-	isTaskCode := false
-	var taskId int64
 	if msg.TaskCode != "" {
-		taskId = encrypts.DecryptNoErr(msg.TaskCode)
-		isTaskCode = true
-	}
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	if !isProjectCode && isTaskCode {
-		projectCode, ok, bError := ps.taskDomain.FindProjectIdByTaskId(taskId)
+		pid, ok, bError := ps.taskDomain.FindProjectIdByTaskId(c, encrypts.DecryptNoErr(msg.TaskCode))
 		if bError != nil {
-			return nil, bError
+			return nil, errs.GrpcError(bError)
 		}
-		if !ok {
-			return &project.FindProjectByMemberIdResponse{
-				Project:  nil,
-				IsOwner:  false,
-				IsMember: false,
-			}, nil
+		if !ok || !sameProject(pid) {
+			return &project.FindProjectByMemberIdResponse{}, nil
 		}
-		projectId = projectCode
-		isProjectCode = true
 	}
-	if isProjectCode {
-		//根据projectid和memberid查询
-		pm, err := ps.projectRepo.FindProjectByPIdAndMemId(c, projectId, msg.MemberId)
+	if msg.StageCode != "" {
+		stage, err := ps.taskStagesRepo.FindById(c, int(encrypts.DecryptNoErr(msg.StageCode)))
 		if err != nil {
-			return nil, model.DBError
-		}
-		if pm == nil {
-			return &project.FindProjectByMemberIdResponse{
-				Project:  nil,
-				IsOwner:  false,
-				IsMember: false,
-			}, nil
+			logs.Ctx(ctx).Error("project FindProjectByMemberId taskStagesRepo.FindById error", zap.Error(err))
+			return nil, errs.GrpcError(model.DBError)
 		}
-		projectMessage := &project.ProjectMessage{}
-		copier.Copy(projectMessage, pm)
-		isOwner := false
-		if pm.IsOwner == 1 {
-			isOwner = true
+		if stage == nil || stage.Id == 0 || !sameProject(stage.ProjectCode) {
+			return &project.FindProjectByMemberIdResponse{}, nil
 		}
-		return &project.FindProjectByMemberIdResponse{
-			Project:  projectMessage,
-			IsOwner:  isOwner,
-			IsMember: true,
-		}, nil
 	}
-	return &project.FindProjectByMemberIdResponse{}, nil
+	if !resolved {
+		return &project.FindProjectByMemberIdResponse{}, nil
+	}
+	//根据projectid和memberid查询
+	pm, err := ps.projectRepo.FindProjectByPIdAndMemId(c, projectId, msg.MemberId)
+	if err != nil {
+		logs.Ctx(ctx).Error("project FindProjectByMemberId projectRepo.FindProjectByPIdAndMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if pm == nil {
+		return &project.FindProjectByMemberIdResponse{}, nil
+	}
+	projectMessage := &project.ProjectMessage{}
+	copier.Copy(projectMessage, pm)
+	// 网关把校验通过的项目编码传给后续的接口，接口据此校验任务是否属于该项目
+	projectMessage.Code = encrypts.EncryptNoErr(projectId)
+	role := pm.ProjectRole()
+	return &project.FindProjectByMemberIdResponse{
+		Project:  projectMessage,
+		IsOwner:  role == model.ProjectRoleOwner,
+		IsMember: true,
+		Role:     role,
+	}, nil
+}
+
+// UpdateProjectMemberRole 修改项目成员的角色
+// 只有项目的拥有者和管理员可以修改，拥有者的角色不能修改，也不能把其他成员设置为拥有者
+func (ps *ProjectService) UpdateProjectMemberRole(ctx context.Context, msg *project.ProjectMemberRoleMessage) (*project.ProjectMemberRoleResponse, error) {
+	switch msg.Role {
+	case model.ProjectRoleAdmin, model.ProjectRoleMember, model.ProjectRoleViewer:
+	default:
+		return nil, errs.GrpcError(model.ProjectRoleError)
+	}
+	projectCode := encrypts.DecryptNoErr(msg.ProjectCode)
+	memberCode := encrypts.DecryptNoErr(msg.MemberCode)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	//1. 发起修改的成员必须是项目的拥有者或管理员
+	operator, err := ps.projectRepo.FindProjectByPIdAndMemId(c, projectCode, msg.MemberId)
+	if err != nil {
+		logs.Ctx(ctx).Error("project UpdateProjectMemberRole FindProjectByPIdAndMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if operator == nil {
+		return nil, errs.GrpcError(model.NotProjectMember)
+	}
+	operatorRole := operator.ProjectRole()
+	if operatorRole != model.ProjectRoleOwner && operatorRole != model.ProjectRoleAdmin {
+		return nil, errs.GrpcError(model.ProjectNoPermission)
+	}
+	//2. 被修改的成员必须是项目成员，且不是拥有者
+	target, err := ps.projectRepo.FindProjectByPIdAndMemId(c, projectCode, memberCode)
+	if err != nil {
+		logs.Ctx(ctx).Error("project UpdateProjectMemberRole FindProjectByPIdAndMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if target == nil {
+		return nil, errs.GrpcError(model.NotProjectMember)
+	}
+	if target.ProjectRole() == model.ProjectRoleOwner {
+		return nil, errs.GrpcError(model.ProjectNoPermission)
+	}
+	err = ps.projectRepo.UpdateProjectMemberRole(c, projectCode, memberCode, msg.Role)
+	if err != nil {
+		logs.Ctx(ctx).Error("project UpdateProjectMemberRole UpdateProjectMemberRole error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	return &project.ProjectMemberRoleResponse{}, nil
 }
@@ -0,0 +1,25 @@
+package task_service_v1
+
+import (
+	"context"
+	"encoding/json"
+	"go.uber.org/zap"
+	"project-common/encrypts"
+	"project-common/logs"
+	"project-project/pkg/model"
+	"time"
+)
+
+// publishBoardEvent 发布看板变化事件，发布失败只记录日志，不影响任务本身的修改
+func (t *TaskService) publishBoardEvent(ctx context.Context, memberId int64, event *model.BoardEvent) {
+	if memberId != 0 {
+		event.MemberCode = encrypts.EncryptNoErr(memberId)
+	}
+	event.Time = time.Now().UnixMilli()
+	bytes, _ := json.Marshal(event)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
+	defer cancel()
+	if err := t.cache.Publish(ctx, model.BoardEventChannel, string(bytes)); err != nil {
+		logs.Ctx(ctx).Error("project task publishBoardEvent cache.Publish error", zap.String("type", event.Type), zap.Error(err))
+	}
+}
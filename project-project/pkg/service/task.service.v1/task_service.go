@@ -8,6 +8,7 @@ import (
 	"project-common/encrypts"
 	"project-common/errs"
 	"project-common/kafka"
+	"project-common/logs"
 	"project-common/tms"
 	"project-grpc/task"
 	"project-grpc/user/login"
@@ -59,21 +60,21 @@ func New() *TaskService {
 
 // TaskStages 获取任务阶段信息
 // 该方法根据项目代码、页码和页面大小获取任务阶段信息
-func (t *TaskService) TaskStages(co context.Context, msg *task.TaskReqMessage) (*task.TaskStagesResponse, error) {
+func (t *TaskService) TaskStages(ctx context.Context, msg *task.TaskReqMessage) (*task.TaskStagesResponse, error) {
 	// 解密项目代码
 	projectCode := encrypts.DecryptNoErr(msg.ProjectCode)
 	// 获取页码和页面大小
 	page := msg.Page
 	pageSize := msg.PageSize
 	// 创建一个带有超时的上下文，以防止操作无限期阻塞
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// 调用存储库方法获取任务阶段信息
 	stages, total, err := t.taskStagesRepo.FindStagesByProjectId(ctx, projectCode, page, pageSize)
 	if err != nil {
 		// 如果发生错误，记录错误信息并返回通用的 gRPC 错误
-		zap.L().Error("project SaveProject taskStagesRepo.FindStagesByProjectId error", zap.Error(err))
+		logs.Ctx(ctx).Error("project SaveProject taskStagesRepo.FindStagesByProjectId error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
@@ -105,15 +106,15 @@ func (t *TaskService) TaskStages(co context.Context, msg *task.TaskReqMessage) (
 // MemberProjectList 查询项目成员列表
 // 该方法首先根据项目代码查询项目成员信息，然后根据成员ID请求用户信息，
 // 最后组装并返回项目成员的详细信息列表。
-func (t *TaskService) MemberProjectList(co context.Context, msg *task.TaskReqMessage) (*task.MemberProjectResponse, error) {
+func (t *TaskService) MemberProjectList(ctx context.Context, msg *task.TaskReqMessage) (*task.MemberProjectResponse, error) {
 	// 1. 去 project_member表 去查询 用户id列表
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	projectCode := encrypts.DecryptNoErr(msg.ProjectCode)
 	// 查询项目成员信息通过项目code
 	projectMembers, total, err := t.projectRepo.FindProjectMemberByPid(ctx, projectCode)
 	if err != nil {
-		zap.L().Error("project MemberProjectList projectRepo.FindProjectMemberByPid error", zap.Error(err))
+		logs.Ctx(ctx).Error("project MemberProjectList projectRepo.FindProjectMemberByPid error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	// 2.拿上用户id列表 去请求用户信息
@@ -135,7 +136,7 @@ func (t *TaskService) MemberProjectList(co context.Context, msg *task.TaskReqMes
 	// 调用 RPC 客户端请求用户信息
 	memberMessageList, err := rpc.LoginServiceClient.FindMemInfoByIds(ctx, userMsg)
 	if err != nil {
-		zap.L().Error("project MemberProjectList LoginServiceClient.FindMemInfoByIds error", zap.Error(err))
+		logs.Ctx(ctx).Error("project MemberProjectList LoginServiceClient.FindMemInfoByIds error", zap.Error(err))
 		return nil, err
 	}
 	var list []*task.MemberProjectMessage
@@ -163,13 +164,22 @@ func (t *TaskService) TaskList(ctx context.Context, msg *task.TaskReqMessage) (*
 	// 解密阶段代码
 	stageCode := encrypts.DecryptNoErr(msg.StageCode)
 	// 创建一个带有超时的上下文，以防止长时间运行的任务导致服务阻塞
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	// 任务阶段必须属于网关校验过权限的项目
+	stage, err := t.taskStagesRepo.FindById(c, int(stageCode))
+	if err != nil {
+		logs.Ctx(ctx).Error("project task TaskList taskStagesRepo.FindById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if stage == nil || stage.Id == 0 || stage.ProjectCode != encrypts.DecryptNoErr(msg.ProjectCode) {
+		return nil, errs.GrpcError(model.TaskStagesNotNull)
+	}
 	// 根据阶段代码查询任务列表
 	taskList, err := t.taskRepo.FindTaskByStageCode(c, int(stageCode))
 	if err != nil {
 		// 记录错误日志，并返回数据库错误
-		zap.L().Error("project task TaskList FindTaskByStageCode error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task TaskList FindTaskByStageCode error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	// 初始化任务显示列表和成员ID列表
@@ -185,7 +195,7 @@ func (t *TaskService) TaskList(ctx context.Context, msg *task.TaskReqMessage) (*
 			taskMember, err := t.taskRepo.FindTaskMemberByTaskId(ctx, v.Id, msg.MemberId)
 			if err != nil {
 				// 记录错误日志，并返回数据库错误
-				zap.L().Error("project task TaskList taskRepo.FindTaskMemberByTaskId error", zap.Error(err))
+				logs.Ctx(ctx).Error("project task TaskList taskRepo.FindTaskMemberByTaskId error", zap.Error(err))
 				return nil, errs.GrpcError(model.DBError)
 			}
 			// 根据查询结果设置任务的可读状态
@@ -208,7 +218,7 @@ func (t *TaskService) TaskList(ctx context.Context, msg *task.TaskReqMessage) (*
 	messageList, err := rpc.LoginServiceClient.FindMemInfoByIds(ctx, &login.UserMessage{MIds: mIds})
 	if err != nil {
 		// 记录错误日志，并返回查询成员信息时遇到的错误
-		zap.L().Error("project task TaskList LoginServiceClient.FindMemInfoByIds error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task TaskList LoginServiceClient.FindMemInfoByIds error", zap.Error(err))
 		return nil, err
 	}
 	// 将查询到的成员信息存储到映射中，以便后续快速查找
@@ -245,17 +255,18 @@ func (t *TaskService) SaveTask(ctx context.Context, msg *task.TaskReqMessage) (*
 	stageCode := encrypts.DecryptNoErr(msg.StageCode)
 	taskStages, err := t.taskStagesRepo.FindById(ctx, int(stageCode))
 	if err != nil {
-		zap.L().Error("project task SaveTask taskStagesRepo.FindById error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTask taskStagesRepo.FindById error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
-	if taskStages == nil {
+	projectCode := encrypts.DecryptNoErr(msg.ProjectCode)
+	// 任务阶段必须属于该项目，网关只校验了项目权限
+	if taskStages == nil || taskStages.ProjectCode != projectCode {
 		return nil, errs.GrpcError(model.TaskStagesNotNull)
 	}
-	projectCode := encrypts.DecryptNoErr(msg.ProjectCode)
 	// 查询项目信息
 	project, err := t.projectRepo.FindProjectById(ctx, projectCode)
 	if err != nil {
-		zap.L().Error("project task SaveTask projectRepo.FindProjectById error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTask projectRepo.FindProjectById error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if project == nil || project.Deleted == model.Deleted {
@@ -264,7 +275,7 @@ func (t *TaskService) SaveTask(ctx context.Context, msg *task.TaskReqMessage) (*
 	// 查询当前项目下的最大任务编号和最大任务排序号
 	maxIdNum, err := t.taskRepo.FindTaskMaxIdNum(ctx, projectCode)
 	if err != nil {
-		zap.L().Error("project task SaveTask taskRepo.FindTaskMaxIdNum error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTask taskRepo.FindTaskMaxIdNum error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if maxIdNum == nil {
@@ -274,7 +285,7 @@ func (t *TaskService) SaveTask(ctx context.Context, msg *task.TaskReqMessage) (*
 	// 查询当前阶段下的最大任务排序号
 	maxSort, err := t.taskRepo.FindTaskSort(ctx, projectCode, stageCode)
 	if err != nil {
-		zap.L().Error("project task SaveTask taskRepo.FindTaskSort error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTask taskRepo.FindTaskSort error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if maxSort == nil {
@@ -298,7 +309,7 @@ func (t *TaskService) SaveTask(ctx context.Context, msg *task.TaskReqMessage) (*
 	err = t.transaction.Action(func(conn database.DbConn) error {
 		err = t.taskRepo.SaveTask(ctx, conn, ts)
 		if err != nil {
-			zap.L().Error("project task SaveTask taskRepo.SaveTask error", zap.Error(err))
+			logs.Ctx(ctx).Error("project task SaveTask taskRepo.SaveTask error", zap.Error(err))
 			return errs.GrpcError(model.DBError)
 		}
 
@@ -313,7 +324,7 @@ func (t *TaskService) SaveTask(ctx context.Context, msg *task.TaskReqMessage) (*
 		}
 		err = t.taskRepo.SaveTaskMember(ctx, conn, tm)
 		if err != nil {
-			zap.L().Error("project task SaveTask taskRepo.SaveTaskMember error", zap.Error(err))
+			logs.Ctx(ctx).Error("project task SaveTask taskRepo.SaveTaskMember error", zap.Error(err))
 			return errs.GrpcError(model.DBError)
 		}
 		return nil
@@ -334,13 +345,19 @@ func (t *TaskService) SaveTask(ctx context.Context, msg *task.TaskReqMessage) (*
 	}
 	tm := &task.TaskMessage{}
 	copier.Copy(tm, display)
+	t.publishBoardEvent(ctx, msg.MemberId, &model.BoardEvent{
+		Type:        model.TaskCreated,
+		ProjectCode: display.ProjectCode,
+		StageCode:   display.StageCode,
+		TaskCode:    display.Code,
+	})
 	return tm, nil
 }
 
 // EditTask 修改任务信息，包括任务的基本信息、分配信息等。
 func (t *TaskService) EditTask(ctx context.Context, msg *task.TaskReqMessage) (*task.TaskMessage, error) {
 	fmt.Println("FindProjectIdByTaskId")
-	config.SendLog(kafka.Info("EditTask", "TaskService.EditTask", kafka.FieldMap{
+	config.SendLog(kafka.Info(ctx, "EditTask", "TaskService.EditTask", kafka.FieldMap{
 		"taskId": msg.TaskCode,
 	}))
 	//1. 检查业务逻辑
@@ -348,6 +365,9 @@ func (t *TaskService) EditTask(ctx context.Context, msg *task.TaskReqMessage) (*
 		return nil, errs.GrpcError(model.TaskNameNotNull)
 	}
 	taskCode := encrypts.DecryptNoErr(msg.TaskCode)
+	if _, err := t.projectTask(ctx, msg.TaskCode, msg.ProjectCode); err != nil {
+		return nil, err
+	}
 
 	ts := &data.Task{
 		Name: msg.Name,
@@ -355,7 +375,7 @@ func (t *TaskService) EditTask(ctx context.Context, msg *task.TaskReqMessage) (*
 	err := t.transaction.Action(func(conn database.DbConn) error {
 		err := t.taskRepo.EditTask(ctx, conn, ts, taskCode)
 		if err != nil {
-			zap.L().Error("project task SaveTask taskRepo.SaveTask error", zap.Error(err))
+			logs.Ctx(ctx).Error("project task SaveTask taskRepo.SaveTask error", zap.Error(err))
 			return errs.GrpcError(model.DBError)
 		}
 		return nil
@@ -364,6 +384,18 @@ func (t *TaskService) EditTask(ctx context.Context, msg *task.TaskReqMessage) (*
 	if err != nil {
 		return nil, err
 	}
+	// 查询修改后的任务，用于确定事件所属的项目和阶段
+	edited, err := t.taskRepo.FindTaskById(ctx, taskCode)
+	if err != nil {
+		logs.Ctx(ctx).Error("project task EditTask taskRepo.FindTaskById error", zap.Error(err))
+	} else if edited != nil {
+		t.publishBoardEvent(ctx, msg.MemberId, &model.BoardEvent{
+			Type:        model.TaskUpdated,
+			ProjectCode: encrypts.EncryptNoErr(edited.ProjectCode),
+			StageCode:   encrypts.EncryptNoErr(int64(edited.StageCode)),
+			TaskCode:    msg.TaskCode,
+		})
+	}
 	display := ts.ToTaskDisplay()
 	tm := &task.TaskMessage{}
 	copier.Copy(tm, display)
@@ -382,11 +414,38 @@ func (t *TaskService) TaskSort(ctx context.Context, msg *task.TaskReqMessage) (*
 		return &task.TaskSortResponse{}, nil
 	}
 
+	// 查询移动前的任务，用于确定事件所属的项目和任务原来所在的阶段
+	ts, err := t.taskRepo.FindTaskById(ctx, preTaskCode)
+	if err != nil {
+		logs.Ctx(ctx).Error("project task TaskSort taskRepo.FindTaskById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if ts == nil || ts.Id == 0 || ts.ProjectCode != encrypts.DecryptNoErr(msg.ProjectCode) {
+		return nil, errs.GrpcError(model.TaskNotExist)
+	}
+	// 只能移动到任务所在项目的阶段，网关按被移动的任务校验了项目权限
+	toStage, err := t.taskStagesRepo.FindById(ctx, int(toStageCode))
+	if err != nil {
+		logs.Ctx(ctx).Error("project task TaskSort taskStagesRepo.FindById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if toStage == nil || toStage.ProjectCode != ts.ProjectCode {
+		return nil, errs.GrpcError(model.TaskStagesNotNull)
+	}
+
 	// 调用sortTask方法进行任务排序，如果排序失败，则返回相应的错误。
-	err := t.sortTask(preTaskCode, msg.NextTaskCode, toStageCode)
+	err = t.sortTask(preTaskCode, msg.NextTaskCode, toStageCode)
 	if err != nil {
 		return nil, err
 	}
+	t.publishBoardEvent(ctx, msg.MemberId, &model.BoardEvent{
+		Type:          model.TaskMoved,
+		ProjectCode:   encrypts.EncryptNoErr(ts.ProjectCode),
+		StageCode:     msg.ToStageCode,
+		FromStageCode: encrypts.EncryptNoErr(int64(ts.StageCode)),
+		TaskCode:      msg.PreTaskCode,
+		NextTaskCode:  msg.NextTaskCode,
+	})
 
 	// 如果排序成功，返回空响应对象。
 	return &task.TaskSortResponse{}, nil
@@ -498,7 +557,7 @@ func (t *TaskService) MyTaskList(ctx context.Context, msg *task.TaskReqMessage)
 		// 我执行的任务
 		tsList, total, err = t.taskRepo.FindTaskByAssignTo(ctx, msg.MemberId, int(msg.Type), msg.Page, msg.PageSize)
 		if err != nil {
-			zap.L().Error("project task MyTaskList taskRepo.FindTaskByAssignTo error", zap.Error(err))
+			logs.Ctx(ctx).Error("project task MyTaskList taskRepo.FindTaskByAssignTo error", zap.Error(err))
 			return nil, errs.GrpcError(model.DBError)
 		}
 	}
@@ -506,7 +565,7 @@ func (t *TaskService) MyTaskList(ctx context.Context, msg *task.TaskReqMessage)
 		// 我参与的任务
 		tsList, total, err = t.taskRepo.FindTaskByMemberCode(ctx, msg.MemberId, int(msg.Type), msg.Page, msg.PageSize)
 		if err != nil {
-			zap.L().Error("project task MyTaskList taskRepo.FindTaskByMemberCode error", zap.Error(err))
+			logs.Ctx(ctx).Error("project task MyTaskList taskRepo.FindTaskByMemberCode error", zap.Error(err))
 			return nil, errs.GrpcError(model.DBError)
 		}
 	}
@@ -514,7 +573,7 @@ func (t *TaskService) MyTaskList(ctx context.Context, msg *task.TaskReqMessage)
 		// 我创建的任务
 		tsList, total, err = t.taskRepo.FindTaskByCreateBy(ctx, msg.MemberId, int(msg.Type), msg.Page, msg.PageSize)
 		if err != nil {
-			zap.L().Error("project task MyTaskList taskRepo.FindTaskByCreateBy error", zap.Error(err))
+			logs.Ctx(ctx).Error("project task MyTaskList taskRepo.FindTaskByCreateBy error", zap.Error(err))
 			return nil, errs.GrpcError(model.DBError)
 		}
 	}
@@ -560,24 +619,27 @@ func (t *TaskService) MyTaskList(ctx context.Context, msg *task.TaskReqMessage)
 func (t *TaskService) ReadTask(ctx context.Context, msg *task.TaskReqMessage) (*task.TaskMessage, error) {
 	// 根据taskCode查询任务详情 根据任务查询项目详情 根据任务查询任务步骤详情 查询任务的执行者的成员详情
 	taskCode := encrypts.DecryptNoErr(msg.TaskCode)
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	// 查询任务详情
 	taskInfo, err := t.taskRepo.FindTaskById(c, taskCode)
 	if err != nil {
-		zap.L().Error("project task ReadTask taskRepo FindTaskById error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task ReadTask taskRepo FindTaskById error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if taskInfo == nil {
 		return &task.TaskMessage{}, nil
 	}
+	if taskInfo.Id == 0 || taskInfo.ProjectCode != encrypts.DecryptNoErr(msg.ProjectCode) {
+		return nil, errs.GrpcError(model.TaskNotExist)
+	}
 	display := taskInfo.ToTaskDisplay()
 	if taskInfo.Private == 1 {
 		//代表隐私模式
 		// FindTaskMemberByTaskId 根据任务ID和成员ID查找任务成员
 		taskMember, err := t.taskRepo.FindTaskMemberByTaskId(ctx, taskInfo.Id, msg.MemberId)
 		if err != nil {
-			zap.L().Error("project task TaskList taskRepo.FindTaskMemberByTaskId error", zap.Error(err))
+			logs.Ctx(ctx).Error("project task TaskList taskRepo.FindTaskMemberByTaskId error", zap.Error(err))
 			return nil, errs.GrpcError(model.DBError)
 		}
 		if taskMember != nil {
@@ -595,7 +657,7 @@ func (t *TaskService) ReadTask(ctx context.Context, msg *task.TaskReqMessage) (*
 	// 查询任务的执行者的成员详情
 	memberMessage, err := rpc.LoginServiceClient.FindMemInfoById(ctx, &login.UserMessage{MemId: taskInfo.AssignTo})
 	if err != nil {
-		zap.L().Error("project task TaskList LoginServiceClient.FindMemInfoById error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task TaskList LoginServiceClient.FindMemInfoById error", zap.Error(err))
 		return nil, err
 	}
 	// 构建执行者的成员详情
@@ -613,12 +675,15 @@ func (t *TaskService) ReadTask(ctx context.Context, msg *task.TaskReqMessage) (*
 func (t *TaskService) ListTaskMember(ctx context.Context, msg *task.TaskReqMessage) (*task.TaskMemberList, error) {
 	//查询 task member表 根据memberCode去查询用户信息
 	taskCode := encrypts.DecryptNoErr(msg.TaskCode)
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	if _, err := t.projectTask(c, msg.TaskCode, msg.ProjectCode); err != nil {
+		return nil, err
+	}
 	// 查询任务成员列表
 	taskMemberPage, total, err := t.taskRepo.FindTaskMemberPage(c, taskCode, msg.Page, msg.PageSize)
 	if err != nil {
-		zap.L().Error("project task TaskList taskRepo.FindTaskMemberPage error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task TaskList taskRepo.FindTaskMemberPage error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	var mids []int64
@@ -655,8 +720,11 @@ func (t *TaskService) ListTaskMember(ctx context.Context, msg *task.TaskReqMessa
 func (t *TaskService) TaskLog(ctx context.Context, msg *task.TaskReqMessage) (*task.TaskLogList, error) {
 	taskCode := encrypts.DecryptNoErr(msg.TaskCode)
 	all := msg.All
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	if _, err := t.projectTask(c, msg.TaskCode, msg.ProjectCode); err != nil {
+		return nil, err
+	}
 	// 查询任务日志
 	var list []*data.ProjectLog
 	var total int64
@@ -672,7 +740,7 @@ func (t *TaskService) TaskLog(ctx context.Context, msg *task.TaskReqMessage) (*t
 		list, total, err = t.projectLogRepo.FindLogByTaskCodePage(c, taskCode, int(msg.Comment), int(msg.Page), int(msg.PageSize))
 	}
 	if err != nil {
-		zap.L().Error("project task TaskLog projectLogRepo.FindLogByTaskCodePage error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task TaskLog projectLogRepo.FindLogByTaskCodePage error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if total == 0 {
@@ -713,14 +781,17 @@ func (t *TaskService) TaskLog(ctx context.Context, msg *task.TaskReqMessage) (*t
 // TaskWorkTimeList 获取任务工时
 func (t *TaskService) TaskWorkTimeList(ctx context.Context, msg *task.TaskReqMessage) (*task.TaskWorkTimeResponse, error) {
 	taskCode := encrypts.DecryptNoErr(msg.TaskCode)
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	if _, err := t.projectTask(c, msg.TaskCode, msg.ProjectCode); err != nil {
+		return nil, err
+	}
 	var list []*data.TaskWorkTime
 	var err error
 	// FindWorkTimeList 查询任务工时
 	list, err = t.taskWorkTimeRepo.FindWorkTimeList(c, taskCode)
 	if err != nil {
-		zap.L().Error("project task TaskWorkTimeList taskWorkTimeRepo.FindWorkTimeList error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task TaskWorkTimeList taskWorkTimeRepo.FindWorkTimeList error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if len(list) == 0 {
@@ -764,12 +835,15 @@ func (t *TaskService) SaveTaskWorkTime(ctx context.Context, msg *task.TaskReqMes
 	tmt.Content = msg.Content
 	tmt.TaskCode = encrypts.DecryptNoErr(msg.TaskCode)
 	tmt.MemberCode = msg.MemberId
-	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	c, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	if _, err := t.projectTask(c, msg.TaskCode, msg.ProjectCode); err != nil {
+		return nil, err
+	}
 	// 调用 TaskWorkTimeRepo 的 Save 方法保存任务工时。
 	err := t.taskWorkTimeRepo.Save(c, tmt)
 	if err != nil {
-		zap.L().Error("project task SaveTaskWorkTime taskWorkTimeRepo.Save error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTaskWorkTime taskWorkTimeRepo.Save error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	return &task.SaveTaskWorkTimeResponse{}, nil
@@ -778,6 +852,9 @@ func (t *TaskService) SaveTaskWorkTime(ctx context.Context, msg *task.TaskReqMes
 // SaveTaskFile 保存任务文件
 func (t *TaskService) SaveTaskFile(ctx context.Context, msg *task.TaskFileReqMessage) (*task.TaskFileResponse, error) {
 	taskCode := encrypts.DecryptNoErr(msg.TaskCode)
+	if _, err := t.projectTask(ctx, msg.TaskCode, msg.ProjectCode); err != nil {
+		return nil, err
+	}
 	//存file表
 	f := &data.File{
 		PathName:         msg.PathName,
@@ -798,9 +875,9 @@ func (t *TaskService) SaveTaskFile(ctx context.Context, msg *task.TaskFileReqMes
 		DeletedTime:      0,
 	}
 	// 调用 FileRepo 的 Save 方法保存文件。
-	err := t.fileRepo.Save(context.Background(), f)
+	err := t.fileRepo.Save(ctx, f)
 	if err != nil {
-		zap.L().Error("project task SaveTaskFile fileRepo.Save error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTaskFile fileRepo.Save error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	//存入source_link（关联文件数据）
@@ -815,9 +892,9 @@ func (t *TaskService) SaveTaskFile(ctx context.Context, msg *task.TaskFileReqMes
 		Sort:             0,
 	}
 	// 调用 SourceLinkRepo 的 Save 方法保存关联文件数据。
-	err = t.sourceLinkRepo.Save(context.Background(), sl)
+	err = t.sourceLinkRepo.Save(ctx, sl)
 	if err != nil {
-		zap.L().Error("project task SaveTaskFile sourceLinkRepo.Save error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTaskFile sourceLinkRepo.Save error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	return &task.TaskFileResponse{}, nil
@@ -826,10 +903,13 @@ func (t *TaskService) SaveTaskFile(ctx context.Context, msg *task.TaskFileReqMes
 // TaskSources 获取任务关联文件
 func (t *TaskService) TaskSources(ctx context.Context, msg *task.TaskReqMessage) (*task.TaskSourceResponse, error) {
 	taskCode := encrypts.DecryptNoErr(msg.TaskCode)
+	if _, err := t.projectTask(ctx, msg.TaskCode, msg.ProjectCode); err != nil {
+		return nil, err
+	}
 	// 通过任务编码查询关联文件数据
-	sourceLinks, err := t.sourceLinkRepo.FindByTaskCode(context.Background(), taskCode)
+	sourceLinks, err := t.sourceLinkRepo.FindByTaskCode(ctx, taskCode)
 	if err != nil {
-		zap.L().Error("project task SaveTaskFile sourceLinkRepo.FindByTaskCode error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTaskFile sourceLinkRepo.FindByTaskCode error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if len(sourceLinks) == 0 {
@@ -840,9 +920,9 @@ func (t *TaskService) TaskSources(ctx context.Context, msg *task.TaskReqMessage)
 		fIdList = append(fIdList, v.SourceCode)
 	}
 	// 通过文件id查询文件数据
-	files, err := t.fileRepo.FindByIds(context.Background(), fIdList)
+	files, err := t.fileRepo.FindByIds(ctx, fIdList)
 	if err != nil {
-		zap.L().Error("project task SaveTaskFile fileRepo.FindByIds error", zap.Error(err))
+		logs.Ctx(ctx).Error("project task SaveTaskFile fileRepo.FindByIds error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	fMap := make(map[int64]*data.File)
@@ -862,10 +942,9 @@ func (t *TaskService) TaskSources(ctx context.Context, msg *task.TaskReqMessage)
 func (t *TaskService) CreateComment(ctx context.Context, msg *task.TaskReqMessage) (*task.CreateCommentResponse, error) {
 	taskCode := encrypts.DecryptNoErr(msg.TaskCode)
 	// 通过任务编码查询任务数据
-	taskById, err := t.taskRepo.FindTaskById(context.Background(), taskCode)
+	taskById, err := t.projectTask(ctx, msg.TaskCode, msg.ProjectCode)
 	if err != nil {
-		zap.L().Error("project task CreateComment fileRepo.FindTaskById error", zap.Error(err))
-		return nil, errs.GrpcError(model.DBError)
+		return nil, err
 	}
 	pl := &data.ProjectLog{
 		MemberCode:   msg.MemberId,
@@ -885,3 +964,16 @@ func (t *TaskService) CreateComment(ctx context.Context, msg *task.TaskReqMessag
 	t.projectLogRepo.SaveProjectLog(pl)
 	return &task.CreateCommentResponse{}, nil
 }
+
+// projectTask 查询任务并校验任务属于网关校验过权限的项目，任务不存在或属于其他项目时返回 TaskNotExist
+func (t *TaskService) projectTask(ctx context.Context, taskCode string, projectCode string) (*data.Task, error) {
+	ts, err := t.taskRepo.FindTaskById(ctx, encrypts.DecryptNoErr(taskCode))
+	if err != nil {
+		logs.Ctx(ctx).Error("project task projectTask taskRepo.FindTaskById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if ts == nil || ts.Id == 0 || ts.ProjectCode != encrypts.DecryptNoErr(projectCode) {
+		return nil, errs.GrpcError(model.TaskNotExist)
+	}
+	return ts, nil
+}
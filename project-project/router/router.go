@@ -8,6 +8,8 @@ import (
 	"net"
 	"project-common/discovery"
 	"project-common/logs"
+	"project-common/metrics"
+	"project-common/tracing"
 	"project-grpc/account"
 	"project-grpc/auth"
 	"project-grpc/department"
@@ -72,7 +74,7 @@ func RegisterGrpc() *grpc.Server {
 			menu.RegisterMenuServiceServer(g, menu_service_v1.New())
 		}}
 	// 创建grpc服务——注册缓存
-	s := grpc.NewServer(interceptor.New().Cache())
+	s := grpc.NewServer(append(tracing.ServerOptions(), metrics.UnaryServerInterceptor(), interceptor.New().Cache())...)
 	c.RegisterFunc(s)
 	lis, err := net.Listen("tcp", c.Addr)
 	if err != nil {
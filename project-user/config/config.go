@@ -1,11 +1,15 @@
 package config
 
 import (
+	"fmt"
 	"github.com/go-redis/redis/v8"
 	"github.com/spf13/viper"
 	"log"
 	"os"
+	"path/filepath"
+	"project-common/jwts"
 	"project-common/logs"
+	"project-common/tracing"
 )
 
 // C 是全局配置变量，用于存储应用程序的配置信息
@@ -13,12 +17,16 @@ var C = InitConfig()
 
 // Config 是应用程序配置的结构体，包含viper实例和配置信息的子结构体
 type Config struct {
-	viper       *viper.Viper
-	SC          *ServerConfig
-	GC          *GrpcConfig
-	EtcdConfig  *EtcdConfig
-	MysqlConfig *MysqlConfig
-	JwtConfig   *JwtConfig
+	viper         *viper.Viper
+	SC            *ServerConfig
+	GC            *GrpcConfig
+	EtcdConfig    *EtcdConfig
+	MysqlConfig   *MysqlConfig
+	JwtConfig     *JwtConfig
+	CaptchaConfig *CaptchaConfig
+	SmsConfig     *SmsConfig
+	LoginConfig   *LoginConfig
+	Trace         *tracing.Config
 }
 
 // ServerConfig 服务器配置的结构体，包含服务器的名称和地址
@@ -49,12 +57,54 @@ type MysqlConfig struct {
 	Db       string
 }
 
-// JwtConfig JWT配置的结构体，包含JWT的过期时间和密钥
+// JwtConfig JWT配置的结构体，包含JWT的过期时间和密钥。
+// 访问令牌使用 KeySet 中的签名密钥（RS256/EdDSA）签发，其他服务通过 JWKS 获取公钥校验；
+// 刷新令牌只由用户服务自己校验，仍然使用 RefreshSecret。
 type JwtConfig struct {
 	AccessExp     int64
 	RefreshExp    int64
-	AccessSecret  string
 	RefreshSecret string
+	SigningKid    string // 当前用于签发访问令牌的密钥
+	Keys          []JwtKey
+	KeySet        *jwts.KeySet
+}
+
+// JwtKey 访问令牌的密钥文件，相对路径以配置文件所在目录为基准。
+// 私钥不提交到仓库，通过挂载的文件（如 docker secret）或 PrivateKeyEnv 指定的环境变量提供，
+// 环境变量有值时优先使用环境变量。
+// 轮换密钥时新增一个带私钥的密钥并切换 SigningKid，旧密钥只保留公钥，等旧令牌全部过期后再删除。
+type JwtKey struct {
+	Kid           string `mapstructure:"kid"`
+	PrivateKey    string `mapstructure:"privateKey"`
+	PrivateKeyEnv string `mapstructure:"privateKeyEnv"` // 保存PEM格式私钥的环境变量名
+	PublicKey     string `mapstructure:"publicKey"`
+}
+
+// CaptchaConfig 验证码配置的结构体，包含验证码的有效期、可尝试次数和发送频率限制
+type CaptchaConfig struct {
+	Dev             bool  // 开发模式下验证码会直接在响应中返回
+	Expire          int64 // 验证码有效期，单位分钟
+	MaxAttempts     int64 // 每个验证码可以尝试校验的次数，超过后验证码作废
+	MobilePerMinute int64 // 每个手机号每分钟可以发送的次数
+	MobilePerDay    int64 // 每个手机号每天可以发送的次数
+	IpPerMinute     int64 // 每个ip每分钟可以发送的次数
+	IpPerDay        int64 // 每个ip每天可以发送的次数
+}
+
+// SmsConfig 短信配置的结构体，Sender 可选 log（只打印日志）或 file（写入文件）
+type SmsConfig struct {
+	Sender   string
+	FileName string
+}
+
+// LoginConfig 登录防暴力破解配置的结构体，包含账号锁定和按ip退避的参数
+type LoginConfig struct {
+	MaxFailures    int64 // 账号在 FailWindow 内连续失败的次数达到该值后锁定账号
+	FailWindow     int64 // 账号失败次数的统计窗口，单位分钟
+	LockMinutes    int64 // 账号锁定时长，单位分钟
+	IpFreeFailures int64 // 每个ip在一小时内可以失败的次数，超过后开始指数退避
+	IpBackoffBase  int64 // ip退避的初始时长，单位秒，之后每失败一次翻倍
+	IpBackoffMax   int64 // ip退避的最大时长，单位秒
 }
 
 // InitConfig 初始化配置，读取配置文件并解析到Config结构体
@@ -75,6 +125,10 @@ func InitConfig() *Config {
 	conf.ReadEtcdConfig()
 	conf.InitMysqlConfig()
 	conf.InitJwtConfig()
+	conf.InitCaptchaConfig()
+	conf.InitSmsConfig()
+	conf.InitLoginConfig()
+	conf.ReadTraceConfig()
 	return conf
 }
 
@@ -148,10 +202,129 @@ func (c *Config) InitMysqlConfig() {
 // InitJwtConfig 初始化JWT配置
 func (c *Config) InitJwtConfig() {
 	mc := &JwtConfig{
-		AccessSecret:  c.viper.GetString("jwt.accessSecret"),
 		AccessExp:     c.viper.GetInt64("jwt.accessExp"),
 		RefreshExp:    c.viper.GetInt64("jwt.refreshExp"),
 		RefreshSecret: c.viper.GetString("jwt.refreshSecret"),
+		SigningKid:    c.viper.GetString("jwt.signingKid"),
 	}
+	if err := c.viper.UnmarshalKey("jwt.keys", &mc.Keys); err != nil {
+		log.Fatalln(err)
+	}
+	ks, err := c.loadKeySet(mc)
+	if err != nil {
+		log.Fatalln("load jwt keys error:", err)
+	}
+	mc.KeySet = ks
 	c.JwtConfig = mc
 }
+
+// loadKeySet 读取密钥文件，SigningKid 对应的密钥必须带有私钥
+func (c *Config) loadKeySet(mc *JwtConfig) (*jwts.KeySet, error) {
+	dir := filepath.Dir(c.viper.ConfigFileUsed())
+	var signing *jwts.Key
+	var verify []*jwts.Key
+	for _, k := range mc.Keys {
+		data, private, err := readJwtKey(dir, k)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k.Kid, err)
+		}
+		var key *jwts.Key
+		if private {
+			key, err = jwts.ParsePrivateKeyPEM(k.Kid, data)
+		} else {
+			key, err = jwts.ParsePublicKeyPEM(k.Kid, data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k.Kid, err)
+		}
+		if k.Kid == mc.SigningKid && private {
+			signing = key
+		} else {
+			verify = append(verify, key)
+		}
+	}
+	if signing == nil {
+		return nil, fmt.Errorf("签名密钥 %s 没有配置私钥", mc.SigningKid)
+	}
+	return jwts.NewKeySet(signing, verify...), nil
+}
+
+// readJwtKey 读取密钥的PEM内容，返回是否为私钥。私钥优先从环境变量读取，其次读取私钥文件，都没有配置时读取公钥文件
+func readJwtKey(dir string, k JwtKey) ([]byte, bool, error) {
+	if k.PrivateKeyEnv != "" {
+		if pem := os.Getenv(k.PrivateKeyEnv); pem != "" {
+			return []byte(pem), true, nil
+		}
+	}
+	file, private := k.PublicKey, false
+	if k.PrivateKey != "" {
+		file, private = k.PrivateKey, true
+	}
+	if file == "" {
+		return nil, false, fmt.Errorf("没有配置密钥文件，环境变量 %s 也为空", k.PrivateKeyEnv)
+	}
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(dir, file)
+	}
+	data, err := os.ReadFile(file)
+	return data, private, err
+}
+
+// InitCaptchaConfig 初始化验证码配置
+func (c *Config) InitCaptchaConfig() {
+	c.viper.SetDefault("captcha.expire", 15)
+	c.viper.SetDefault("captcha.maxAttempts", 5)
+	c.viper.SetDefault("captcha.mobilePerMinute", 1)
+	c.viper.SetDefault("captcha.mobilePerDay", 10)
+	c.viper.SetDefault("captcha.ipPerMinute", 5)
+	c.viper.SetDefault("captcha.ipPerDay", 50)
+	cc := &CaptchaConfig{
+		Dev:             c.viper.GetBool("captcha.dev"),
+		Expire:          c.viper.GetInt64("captcha.expire"),
+		MaxAttempts:     c.viper.GetInt64("captcha.maxAttempts"),
+		MobilePerMinute: c.viper.GetInt64("captcha.mobilePerMinute"),
+		MobilePerDay:    c.viper.GetInt64("captcha.mobilePerDay"),
+		IpPerMinute:     c.viper.GetInt64("captcha.ipPerMinute"),
+		IpPerDay:        c.viper.GetInt64("captcha.ipPerDay"),
+	}
+	c.CaptchaConfig = cc
+}
+
+// InitSmsConfig 初始化短信配置
+func (c *Config) InitSmsConfig() {
+	c.viper.SetDefault("sms.sender", "log")
+	sc := &SmsConfig{
+		Sender:   c.viper.GetString("sms.sender"),
+		FileName: c.viper.GetString("sms.fileName"),
+	}
+	c.SmsConfig = sc
+}
+
+// InitLoginConfig 初始化登录防暴力破解配置
+func (c *Config) InitLoginConfig() {
+	c.viper.SetDefault("login.maxFailures", 5)
+	c.viper.SetDefault("login.failWindow", 15)
+	c.viper.SetDefault("login.lockMinutes", 30)
+	c.viper.SetDefault("login.ipFreeFailures", 5)
+	c.viper.SetDefault("login.ipBackoffBase", 1)
+	c.viper.SetDefault("login.ipBackoffMax", 300)
+	lc := &LoginConfig{
+		MaxFailures:    c.viper.GetInt64("login.maxFailures"),
+		FailWindow:     c.viper.GetInt64("login.failWindow"),
+		LockMinutes:    c.viper.GetInt64("login.lockMinutes"),
+		IpFreeFailures: c.viper.GetInt64("login.ipFreeFailures"),
+		IpBackoffBase:  c.viper.GetInt64("login.ipBackoffBase"),
+		IpBackoffMax:   c.viper.GetInt64("login.ipBackoffMax"),
+	}
+	c.LoginConfig = lc
+}
+
+// ReadTraceConfig 读取链路追踪配置，exporter 为空时只生成 traceId 不导出 span
+func (c *Config) ReadTraceConfig() {
+	c.viper.SetDefault("trace.sampleRatio", 1)
+	c.Trace = &tracing.Config{
+		Exporter:    c.viper.GetString("trace.exporter"),
+		Endpoint:    c.viper.GetString("trace.endpoint"),
+		SampleRatio: c.viper.GetFloat64("trace.sampleRatio"),
+	}
+}
@@ -0,0 +1,22 @@
+package dao
+
+import (
+	"context"
+	"project-user/internal/data/member"
+	"project-user/internal/database/gorms"
+)
+
+type LoginAuditDao struct {
+	conn *gorms.GormConn
+}
+
+func NewLoginAuditDao() *LoginAuditDao {
+	return &LoginAuditDao{
+		conn: gorms.New(),
+	}
+}
+
+// SaveAudit 保存登录审计记录
+func (l *LoginAuditDao) SaveAudit(ctx context.Context, audit *member.LoginAudit) error {
+	return l.conn.Session(ctx).Create(audit).Error
+}
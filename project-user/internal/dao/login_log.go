@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"context"
+	"project-user/internal/data/member"
+	"project-user/internal/database/gorms"
+)
+
+type LoginLogDao struct {
+	conn *gorms.GormConn
+}
+
+func NewLoginLogDao() *LoginLogDao {
+	return &LoginLogDao{
+		conn: gorms.New(),
+	}
+}
+
+// SaveLoginLog 保存登录记录
+func (l *LoginLogDao) SaveLoginLog(ctx context.Context, lg *member.LoginLog) error {
+	return l.conn.Session(ctx).Create(lg).Error
+}
+
+// FindLoginLogs 分页查询成员的登录记录
+func (l *LoginLogDao) FindLoginLogs(ctx context.Context, memId int64, page int64, pageSize int64) (list []*member.LoginLog, total int64, err error) {
+	session := l.conn.Session(ctx)
+	offset := (page - 1) * pageSize
+	err = session.Model(&member.LoginLog{}).Where("member_id=?", memId).
+		Order("id desc").Limit(int(pageSize)).Offset(int(offset)).Find(&list).Error
+	if err != nil {
+		return
+	}
+	err = session.Model(&member.LoginLog{}).Where("member_id=?", memId).Count(&total).Error
+	return
+}
+
+// FindSessionLogs 查询成员在 since 之后成功登录并签发了令牌的记录
+func (l *LoginLogDao) FindSessionLogs(ctx context.Context, memId int64, since int64) ([]*member.LoginLog, error) {
+	var list []*member.LoginLog
+	err := l.conn.Session(ctx).Where("member_id=? and success=1 and family<>'' and create_time>=?", memId, since).
+		Order("id desc").Find(&list).Error
+	return list, err
+}
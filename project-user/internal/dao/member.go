@@ -33,16 +33,46 @@ func (m *MemberDao) FindMemberById(ctx context.Context, id int64) (mem *member.M
 	return
 }
 
-// FindMember 根据账号密码查询用户
-func (m *MemberDao) FindMember(ctx context.Context, account string, pwd string) (*member.Member, error) {
+// FindMemberByAccount 根据账号查询用户
+func (m *MemberDao) FindMemberByAccount(ctx context.Context, account string) (*member.Member, error) {
 	var mem *member.Member
-	err := m.conn.Session(ctx).Where("account=? and password=?", account, pwd).First(&mem).Error
+	err := m.conn.Session(ctx).Where("account=?", account).First(&mem).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
 	return mem, err
 }
 
+// FindMemberByMobile 根据手机号查询用户
+func (m *MemberDao) FindMemberByMobile(ctx context.Context, mobile string) (*member.Member, error) {
+	var mem *member.Member
+	err := m.conn.Session(ctx).Where("mobile=?", mobile).First(&mem).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return mem, err
+}
+
+// FindMemberByEmail 根据邮箱查询用户
+func (m *MemberDao) FindMemberByEmail(ctx context.Context, email string) (*member.Member, error) {
+	var mem *member.Member
+	err := m.conn.Session(ctx).Where("email=?", email).First(&mem).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return mem, err
+}
+
+// UpdatePassword 更新用户密码
+func (m *MemberDao) UpdatePassword(ctx context.Context, id int64, pwd string) error {
+	return m.conn.Session(ctx).Model(&member.Member{}).Where("id=?", id).Update("password", pwd).Error
+}
+
+// UpdateMember 更新用户的部分字段
+func (m *MemberDao) UpdateMember(ctx context.Context, id int64, values map[string]any) error {
+	return m.conn.Session(ctx).Model(&member.Member{}).Where("id=?", id).Updates(values).Error
+}
+
 // SaveMember 保存用户
 func (m *MemberDao) SaveMember(conn database.DbConn, ctx context.Context, mem *member.Member) error {
 	m.conn = conn.(*gorms.GormConn)
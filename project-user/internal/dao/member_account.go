@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"project-user/internal/data/member"
+	"project-user/internal/database"
+	"project-user/internal/database/gorms"
+)
+
+type MemberAccountDao struct {
+	conn *gorms.GormConn
+}
+
+func NewMemberAccountDao() *MemberAccountDao {
+	return &MemberAccountDao{
+		conn: gorms.New(),
+	}
+}
+
+// FindMemberAccount 查询成员在组织中的账号
+func (m *MemberAccountDao) FindMemberAccount(ctx context.Context, orgId int64, memId int64) (*member.MemberAccount, error) {
+	var ma *member.MemberAccount
+	err := m.conn.Session(ctx).Where("organization_code=? and member_code=?", orgId, memId).First(&ma).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return ma, err
+}
+
+// FindMemberAccountsByMemId 查询成员在所有组织中的账号
+func (m *MemberAccountDao) FindMemberAccountsByMemId(ctx context.Context, memId int64) ([]*member.MemberAccount, error) {
+	var list []*member.MemberAccount
+	err := m.conn.Session(ctx).Where("member_code=?", memId).Order("id asc").Find(&list).Error
+	return list, err
+}
+
+// SaveMemberAccount 保存成员账号
+func (m *MemberAccountDao) SaveMemberAccount(conn database.DbConn, ctx context.Context, ma *member.MemberAccount) error {
+	return conn.(*gorms.GormConn).Tx(ctx).Create(ma).Error
+}
+
+// UpdateOwner 修改成员账号的拥有者标记和角色
+func (m *MemberAccountDao) UpdateOwner(conn database.DbConn, ctx context.Context, id int64, isOwner int, authorize string) error {
+	return conn.(*gorms.GormConn).Tx(ctx).Model(&member.MemberAccount{}).Where("id=?", id).
+		Updates(map[string]any{"is_owner": isOwner, "authorize": authorize}).Error
+}
+
+// UpdateByMemId 同步成员在所有组织中账号的冗余字段
+func (m *MemberAccountDao) UpdateByMemId(ctx context.Context, memId int64, values map[string]any) error {
+	return m.conn.Session(ctx).Model(&member.MemberAccount{}).Where("member_code=?", memId).Updates(values).Error
+}
+
+// DeleteMemberAccount 删除成员在组织中的账号
+func (m *MemberAccountDao) DeleteMemberAccount(ctx context.Context, orgId int64, memId int64) error {
+	return m.conn.Session(ctx).Where("organization_code=? and member_code=?", orgId, memId).Delete(&member.MemberAccount{}).Error
+}
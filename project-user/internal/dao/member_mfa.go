@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"project-user/internal/data/member"
+	"project-user/internal/database/gorms"
+)
+
+type MemberMfaDao struct {
+	conn *gorms.GormConn
+}
+
+func NewMemberMfaDao() *MemberMfaDao {
+	return &MemberMfaDao{
+		conn: gorms.New(),
+	}
+}
+
+// FindMfaByMemId 根据成员id查询两步验证配置
+func (m *MemberMfaDao) FindMfaByMemId(ctx context.Context, memId int64) (*member.MemberMfa, error) {
+	var mfa *member.MemberMfa
+	err := m.conn.Session(ctx).Where("member_id=?", memId).First(&mfa).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return mfa, err
+}
+
+// SaveMfa 保存两步验证配置
+func (m *MemberMfaDao) SaveMfa(ctx context.Context, mfa *member.MemberMfa) error {
+	return m.conn.Session(ctx).Save(mfa).Error
+}
+
+// DeleteMfa 删除两步验证配置
+func (m *MemberMfaDao) DeleteMfa(ctx context.Context, memId int64) error {
+	return m.conn.Session(ctx).Where("member_id=?", memId).Delete(&member.MemberMfa{}).Error
+}
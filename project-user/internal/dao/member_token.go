@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"project-user/internal/data/member"
+	"project-user/internal/database/gorms"
+)
+
+type MemberTokenDao struct {
+	conn *gorms.GormConn
+}
+
+func NewMemberTokenDao() *MemberTokenDao {
+	return &MemberTokenDao{
+		conn: gorms.New(),
+	}
+}
+
+// SaveToken 保存个人访问令牌
+func (m *MemberTokenDao) SaveToken(ctx context.Context, token *member.MemberToken) error {
+	return m.conn.Session(ctx).Create(token).Error
+}
+
+// FindTokenByHash 根据令牌哈希查询个人访问令牌
+func (m *MemberTokenDao) FindTokenByHash(ctx context.Context, hash string) (*member.MemberToken, error) {
+	var token *member.MemberToken
+	err := m.conn.Session(ctx).Where("token_hash=?", hash).First(&token).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return token, err
+}
+
+// FindTokensByMemId 查询成员所有未吊销的个人访问令牌
+func (m *MemberTokenDao) FindTokensByMemId(ctx context.Context, memId int64) ([]*member.MemberToken, error) {
+	var list []*member.MemberToken
+	err := m.conn.Session(ctx).Where("member_id=? and revoked=0", memId).Order("id desc").Find(&list).Error
+	return list, err
+}
+
+// RevokeToken 吊销个人访问令牌
+func (m *MemberTokenDao) RevokeToken(ctx context.Context, memId int64, id int64) (bool, error) {
+	result := m.conn.Session(ctx).Model(&member.MemberToken{}).
+		Where("id=? and member_id=? and revoked=0", id, memId).Update("revoked", 1)
+	return result.RowsAffected > 0, result.Error
+}
+
+// UpdateLastUsed 更新令牌最后一次使用的时间和ip
+func (m *MemberTokenDao) UpdateLastUsed(ctx context.Context, id int64, time int64, ip string) error {
+	return m.conn.Session(ctx).Model(&member.MemberToken{}).Where("id=?", id).
+		Updates(map[string]any{"last_used_time": time, "last_used_ip": ip}).Error
+}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"gorm.io/gorm"
 	"project-user/internal/data/organization"
 	"project-user/internal/database"
 	"project-user/internal/database/gorms"
@@ -24,6 +25,32 @@ func (o *OrganizationDao) FindOrganizationByMemId(ctx context.Context, memId int
 	return orgs, err
 }
 
+// FindOrganizationById 根据id查询组织信息
+func (o *OrganizationDao) FindOrganizationById(ctx context.Context, id int64) (*organization.Organization, error) {
+	var org *organization.Organization
+	err := o.conn.Session(ctx).Where("id=?", id).First(&org).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return org, err
+}
+
+// FindOrganizationByIds 根据id列表查询组织信息
+func (o *OrganizationDao) FindOrganizationByIds(ctx context.Context, ids []int64) ([]*organization.Organization, error) {
+	var orgs []*organization.Organization
+	if len(ids) == 0 {
+		return orgs, nil
+	}
+	err := o.conn.Session(ctx).Where("id in (?)", ids).Find(&orgs).Error
+	return orgs, err
+}
+
+// UpdateOwner 修改组织的拥有者
+func (o *OrganizationDao) UpdateOwner(conn database.DbConn, ctx context.Context, id int64, memId int64) error {
+	return conn.(*gorms.GormConn).Tx(ctx).Model(&organization.Organization{}).Where("id=?", id).
+		Update("member_id", memId).Error
+}
+
 // SaveOrganization 保存组织信息
 func (o *OrganizationDao) SaveOrganization(conn database.DbConn, ctx context.Context, org *organization.Organization) error {
 	o.conn = conn.(*gorms.GormConn)
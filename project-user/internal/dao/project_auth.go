@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"project-user/internal/data/organization"
+	"project-user/internal/database"
+	"project-user/internal/database/gorms"
+)
+
+type ProjectAuthDao struct {
+	conn *gorms.GormConn
+}
+
+func NewProjectAuthDao() *ProjectAuthDao {
+	return &ProjectAuthDao{
+		conn: gorms.New(),
+	}
+}
+
+// FindAuthByType 查询组织中指定类型的角色
+func (p *ProjectAuthDao) FindAuthByType(ctx context.Context, orgId int64, authType string) (*organization.ProjectAuth, error) {
+	var auth *organization.ProjectAuth
+	err := p.conn.Session(ctx).Where("organization_code=? and type=?", orgId, authType).First(&auth).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return auth, err
+}
+
+// SaveProjectAuth 保存角色
+func (p *ProjectAuthDao) SaveProjectAuth(conn database.DbConn, ctx context.Context, auth *organization.ProjectAuth) error {
+	return conn.(*gorms.GormConn).Tx(ctx).Create(auth).Error
+}
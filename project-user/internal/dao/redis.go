@@ -31,3 +31,33 @@ func (rc *RedisCache) Get(ctx context.Context, key string) (string, error) {
 	result, err := rc.rdb.Get(ctx, key).Result()
 	return result, err
 }
+
+// Del 删除redis
+func (rc *RedisCache) Del(ctx context.Context, keys ...string) (int64, error) {
+	return rc.rdb.Del(ctx, keys...).Result()
+}
+
+// Incr 自增redis计数
+func (rc *RedisCache) Incr(ctx context.Context, key string) (int64, error) {
+	return rc.rdb.Incr(ctx, key).Result()
+}
+
+// incrWindowScript 固定窗口计数脚本，自增计数，计数没有过期时间时设置为窗口长度，
+// 自增和设置过期时间在同一个脚本中执行，不会留下永不过期的计数
+var incrWindowScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if redis.call('PTTL', KEYS[1]) == -1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
+// IncrWindow 在固定窗口内自增redis计数，窗口从第一次计数开始
+func (rc *RedisCache) IncrWindow(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return incrWindowScript.Run(ctx, rc.rdb, []string{key}, window.Milliseconds()).Int64()
+}
+
+// Publish 发布redis频道消息
+func (rc *RedisCache) Publish(ctx context.Context, channel string, message string) error {
+	return rc.rdb.Publish(ctx, channel, message).Err()
+}
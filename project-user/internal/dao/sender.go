@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"project-user/config"
+	"project-user/internal/repo"
+	"sync"
+	"time"
+)
+
+// NewSmsSender 根据配置创建短信发送器，没有对接短信平台时使用日志或文件代替
+func NewSmsSender() repo.SmsSender {
+	return newSender()
+}
+
+// NewEmailSender 根据配置创建邮件发送器，与短信发送器使用相同的配置
+func NewEmailSender() repo.EmailSender {
+	return newSender()
+}
+
+// fileSender 文件发送器共用一个实例，保证并发写文件时不会交错
+var fileSender = &FileSender{}
+
+func newSender() interface {
+	repo.SmsSender
+	repo.EmailSender
+} {
+	if config.C.SmsConfig.Sender == "file" {
+		fileSender.fileName = config.C.SmsConfig.FileName
+		return fileSender
+	}
+	return &LogSender{}
+}
+
+// LogSender 只把短信和邮件内容打印到日志中，用于本地开发
+type LogSender struct {
+}
+
+// Send 打印短信
+func (*LogSender) Send(ctx context.Context, mobile string, content string) error {
+	zap.L().Info("短信发送", zap.String("mobile", mobile), zap.String("content", content))
+	return nil
+}
+
+// SendEmail 打印邮件
+func (*LogSender) SendEmail(ctx context.Context, email string, subject string, content string) error {
+	zap.L().Info("邮件发送", zap.String("email", email), zap.String("subject", subject), zap.String("content", content))
+	return nil
+}
+
+// FileSender 把短信和邮件内容追加写入文件，用于本地联调时查看验证码
+type FileSender struct {
+	fileName string
+	mu       sync.Mutex
+}
+
+// Send 写入短信
+func (f *FileSender) Send(ctx context.Context, mobile string, content string) error {
+	return f.write(mobile, content)
+}
+
+// SendEmail 写入邮件
+func (f *FileSender) SendEmail(ctx context.Context, email string, subject string, content string) error {
+	return f.write(email, subject+" "+content)
+}
+
+func (f *FileSender) write(to string, content string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(f.fileName), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.fileName, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\n", time.Now().Format(time.DateTime), to, content)
+	return err
+}
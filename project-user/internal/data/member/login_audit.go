@@ -0,0 +1,17 @@
+package member
+
+// LoginAudit 登录审计记录，记录账号锁定、解锁等安全事件
+type LoginAudit struct {
+	Id         int64
+	MemberId   int64
+	Account    string
+	Ip         string
+	Event      string // 事件类型，见 model.AuditLock 等
+	Detail     string
+	OperatorId int64 // 操作人，系统自动触发的事件为0
+	CreateTime int64
+}
+
+func (*LoginAudit) TableName() string {
+	return "member_login_audit"
+}
@@ -0,0 +1,19 @@
+package member
+
+// LoginLog 登录记录，成功登录的记录通过 Family 关联到一个会话（令牌家族）
+type LoginLog struct {
+	Id         int64
+	MemberId   int64 // 账号不存在时为0
+	Account    string
+	Ip         string
+	UserAgent  string
+	Method     string // 登录方式，见 model.LoginMethodPassword 等
+	Success    int
+	Reason     string // 登录失败的原因
+	Family     string // 登录成功时签发的令牌家族，即会话标识
+	CreateTime int64
+}
+
+func (*LoginLog) TableName() string {
+	return "member_login_log"
+}
@@ -0,0 +1,25 @@
+package member
+
+// MemberAccount 成员在组织中的账号，与 project-project 共用 member_account 表
+type MemberAccount struct {
+	Id               int64
+	OrganizationCode int64
+	DepartmentCode   int64
+	MemberCode       int64
+	Authorize        string
+	IsOwner          int
+	Name             string
+	Mobile           string
+	Email            string
+	CreateTime       int64
+	LastLoginTime    int64
+	Status           int
+	Description      string
+	Avatar           string
+	Position         string
+	Department       string
+}
+
+func (*MemberAccount) TableName() string {
+	return "member_account"
+}
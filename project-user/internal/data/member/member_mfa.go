@@ -0,0 +1,16 @@
+package member
+
+// MemberMfa 成员的两步验证配置，每个成员最多一条
+type MemberMfa struct {
+	Id            int64
+	MemberId      int64
+	Secret        string // TOTP密钥，AES加密后存储
+	Enabled       int    // 0 待确认 1 已开启
+	RecoveryCodes string // 恢复码的sha256哈希，逗号分隔，使用过的恢复码会被移除
+	CreateTime    int64
+	EnableTime    int64
+}
+
+func (*MemberMfa) TableName() string {
+	return "member_mfa"
+}
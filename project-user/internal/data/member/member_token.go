@@ -0,0 +1,30 @@
+package member
+
+import "strings"
+
+// MemberToken 个人访问令牌，用于脚本和CI等自动化场景，令牌明文只在创建时返回一次
+type MemberToken struct {
+	Id           int64
+	MemberId     int64
+	Name         string
+	TokenHash    string // 令牌的sha256哈希
+	TokenPrefix  string // 令牌的前几位，用于在列表中辨认令牌
+	Scopes       string // 授权范围，逗号分隔
+	ExpireTime   int64  // 过期时间，0表示永不过期
+	CreateTime   int64
+	LastUsedTime int64
+	LastUsedIp   string
+	Revoked      int
+}
+
+func (*MemberToken) TableName() string {
+	return "member_token"
+}
+
+// ScopeList 返回授权范围列表
+func (t *MemberToken) ScopeList() []string {
+	if t.Scopes == "" {
+		return nil
+	}
+	return strings.Split(t.Scopes, ",")
+}
@@ -0,0 +1,19 @@
+package organization
+
+// ProjectAuth 组织内的角色，与 project-project 共用 project_auth 表
+type ProjectAuth struct {
+	Id               int64
+	OrganizationCode int64
+	Title            string
+	CreateAt         int64
+	Sort             int
+	Status           int
+	Desc             string
+	CreateBy         int64
+	IsDefault        int
+	Type             string
+}
+
+func (*ProjectAuth) TableName() string {
+	return "project_auth"
+}
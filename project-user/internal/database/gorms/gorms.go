@@ -7,6 +7,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"log"
+	"project-common/metrics"
 	"project-user/config"
 )
 
@@ -27,6 +28,10 @@ func init() {
 	if err != nil {
 		panic("连接数据库失败, error=" + err.Error())
 	}
+	// 记录每次数据库操作的耗时
+	if err = _db.Use(metrics.GormPlugin{}); err != nil {
+		panic("注册数据库指标失败, error=" + err.Error())
+	}
 	log.Printf("数据库连接成功！")
 }
 
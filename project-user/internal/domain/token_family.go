@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"project-common/errs"
+	"project-common/jwts"
+	"project-common/logs"
+	"project-user/internal/repo"
+	"project-user/pkg/model"
+	"strconv"
+)
+
+// TokenFamilyDomain 令牌家族和刷新令牌轮换的缓存状态。
+// 每次登录开启一个令牌家族，家族在缓存中记录所属的用户；每个刷新令牌在缓存中登记一次，使用后删除。
+type TokenFamilyDomain struct {
+	cache repo.Cache
+}
+
+func NewTokenFamilyDomain(cache repo.Cache) *TokenFamilyDomain {
+	return &TokenFamilyDomain{
+		cache: cache,
+	}
+}
+
+// FamilyAlive 判断令牌家族是否仍然有效
+func (d *TokenFamilyDomain) FamilyAlive(ctx context.Context, family string) (bool, error) {
+	_, err := d.cache.Get(ctx, model.TokenFamily+"::"+family)
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// TokenVersion 获取用户当前的令牌版本，没有退出过所有设备的用户版本为0
+func (d *TokenFamilyDomain) TokenVersion(ctx context.Context, memIdStr string) (int64, error) {
+	v, err := d.cache.Get(ctx, model.TokenVersion+"::"+memIdStr)
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+// VersionValid 判断令牌的版本是否仍是用户当前的版本
+func (d *TokenFamilyDomain) VersionValid(ctx context.Context, claims *jwts.TokenClaims) (bool, error) {
+	version, err := d.TokenVersion(ctx, claims.Val)
+	if err != nil {
+		return false, err
+	}
+	return claims.Version >= version, nil
+}
+
+// ConsumeRefreshToken 消费一个已经校验过签名的刷新令牌。
+// 令牌家族必须仍然有效并且属于令牌的用户，令牌版本不能低于用户当前的版本；
+// 刷新令牌只能使用一次，已经使用过的令牌被再次提交时说明令牌可能已经泄露，作废整个令牌家族并返回 RefreshTokenReused。
+func (d *TokenFamilyDomain) ConsumeRefreshToken(ctx context.Context, claims *jwts.TokenClaims) *errs.BError {
+	memIdStr, err := d.cache.Get(ctx, model.TokenFamily+"::"+claims.Family)
+	if err == redis.Nil {
+		return model.RefreshTokenError
+	}
+	if err != nil {
+		logs.Ctx(ctx).Error("ConsumeRefreshToken cache get family error", zap.Error(err))
+		return model.RedisError
+	}
+	if memIdStr != claims.Val {
+		return model.RefreshTokenError
+	}
+	// 用户退出所有设备后，旧版本的刷新令牌不能再换取新令牌
+	valid, err := d.VersionValid(ctx, claims)
+	if err != nil {
+		logs.Ctx(ctx).Error("ConsumeRefreshToken VersionValid error", zap.Error(err))
+		return model.RedisError
+	}
+	if !valid {
+		return model.RefreshTokenError
+	}
+	// 删除成功说明是第一次使用；删除不到说明该令牌已经被使用过
+	n, err := d.cache.Del(ctx, model.RefreshToken+"::"+claims.Jti)
+	if err != nil {
+		logs.Ctx(ctx).Error("ConsumeRefreshToken cache del refresh token error", zap.Error(err))
+		return model.RedisError
+	}
+	if n == 0 {
+		logs.Ctx(ctx).Warn("ConsumeRefreshToken reuse detected, revoke token family",
+			zap.String("memberId", claims.Val), zap.String("family", claims.Family))
+		if _, err := d.cache.Del(ctx, model.TokenFamily+"::"+claims.Family); err != nil {
+			logs.Ctx(ctx).Error("ConsumeRefreshToken cache del family error", zap.Error(err))
+			return model.RedisError
+		}
+		return model.RefreshTokenReused
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"project-common/errs"
+	"project-common/jwts"
+	"project-user/pkg/model"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeCache 内存中的缓存，不支持过期
+type fakeCache map[string]string
+
+func (f fakeCache) Put(_ context.Context, key, value string, _ time.Duration) error {
+	f[key] = value
+	return nil
+}
+
+func (f fakeCache) Get(_ context.Context, key string) (string, error) {
+	v, ok := f[key]
+	if !ok {
+		return "", redis.Nil
+	}
+	return v, nil
+}
+
+func (f fakeCache) Del(_ context.Context, keys ...string) (int64, error) {
+	var n int64
+	for _, key := range keys {
+		if _, ok := f[key]; ok {
+			delete(f, key)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (f fakeCache) Incr(_ context.Context, key string) (int64, error) {
+	n, _ := strconv.ParseInt(f[key], 10, 64)
+	n++
+	f[key] = strconv.FormatInt(n, 10)
+	return n, nil
+}
+
+func (f fakeCache) IncrWindow(ctx context.Context, key string, _ time.Duration) (int64, error) {
+	return f.Incr(ctx, key)
+}
+
+func (f fakeCache) Publish(context.Context, string, string) error {
+	return nil
+}
+
+var (
+	familyKey = model.TokenFamily + "::f1"
+	r1Key     = model.RefreshToken + "::r1"
+)
+
+func TestConsumeRefreshToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		cache      fakeCache
+		claims     jwts.TokenClaims
+		want       *errs.BError
+		familyLeft bool // 消费后令牌家族是否仍然有效
+	}{
+		{
+			name:       "first use",
+			cache:      fakeCache{familyKey: "1", r1Key: "f1"},
+			claims:     jwts.TokenClaims{Val: "1", Jti: "r1", Family: "f1"},
+			familyLeft: true,
+		},
+		{
+			name:   "reuse revokes family",
+			cache:  fakeCache{familyKey: "1"},
+			claims: jwts.TokenClaims{Val: "1", Jti: "r1", Family: "f1"},
+			want:   model.RefreshTokenReused,
+		},
+		{
+			name:   "family revoked",
+			cache:  fakeCache{r1Key: "f1"},
+			claims: jwts.TokenClaims{Val: "1", Jti: "r1", Family: "f1"},
+			want:   model.RefreshTokenError,
+		},
+		{
+			name:       "family of another member",
+			cache:      fakeCache{familyKey: "2", r1Key: "f1"},
+			claims:     jwts.TokenClaims{Val: "1", Jti: "r1", Family: "f1"},
+			want:       model.RefreshTokenError,
+			familyLeft: true,
+		},
+		{
+			name:       "version revoked by logout all",
+			cache:      fakeCache{familyKey: "1", r1Key: "f1", model.TokenVersion + "::1": "2"},
+			claims:     jwts.TokenClaims{Val: "1", Jti: "r1", Family: "f1", Version: 1},
+			want:       model.RefreshTokenError,
+			familyLeft: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewTokenFamilyDomain(tt.cache)
+			got := d.ConsumeRefreshToken(context.Background(), &tt.claims)
+			if got != tt.want {
+				t.Errorf("ConsumeRefreshToken() = %v, want %v", got, tt.want)
+			}
+			if _, ok := tt.cache[familyKey]; ok != tt.familyLeft {
+				t.Errorf("family alive = %v, want %v", ok, tt.familyLeft)
+			}
+		})
+	}
+}
+
+// TestRefreshTokenReuseRevokesRotatedToken 旧的刷新令牌被重放后，同一家族中轮换出的新令牌也不能再使用
+func TestRefreshTokenReuseRevokesRotatedToken(t *testing.T) {
+	ctx := context.Background()
+	cache := fakeCache{familyKey: "1", r1Key: "f1"}
+	d := NewTokenFamilyDomain(cache)
+	old := &jwts.TokenClaims{Val: "1", Jti: "r1", Family: "f1"}
+	if err := d.ConsumeRefreshToken(ctx, old); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	// 轮换后签发的新刷新令牌
+	cache[model.RefreshToken+"::r2"] = "f1"
+	rotated := &jwts.TokenClaims{Val: "1", Jti: "r2", Family: "f1"}
+	if err := d.ConsumeRefreshToken(ctx, old); err != model.RefreshTokenReused {
+		t.Fatalf("replay old token: got %v, want %v", err, model.RefreshTokenReused)
+	}
+	if err := d.ConsumeRefreshToken(ctx, rotated); err != model.RefreshTokenError {
+		t.Fatalf("rotated token after reuse: got %v, want %v", err, model.RefreshTokenError)
+	}
+	if alive, _ := d.FamilyAlive(ctx, "f1"); alive {
+		t.Error("family still alive after reuse")
+	}
+}
@@ -10,4 +10,12 @@ type Cache interface {
 	Put(ctx context.Context, key, value string, expire time.Duration) error
 	// Get 获取缓存
 	Get(ctx context.Context, key string) (string, error)
+	// Del 删除缓存，返回实际删除的key数量
+	Del(ctx context.Context, keys ...string) (int64, error)
+	// Incr 自增缓存中的计数，返回自增后的值
+	Incr(ctx context.Context, key string) (int64, error)
+	// IncrWindow 在固定窗口内自增缓存中的计数，返回自增后的值，窗口从第一次计数开始
+	IncrWindow(ctx context.Context, key string, window time.Duration) (int64, error)
+	// Publish 向频道发布消息
+	Publish(ctx context.Context, channel string, message string) error
 }
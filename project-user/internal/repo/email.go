@@ -0,0 +1,8 @@
+package repo
+
+import "context"
+
+type EmailSender interface {
+	// SendEmail 向邮箱发送邮件
+	SendEmail(ctx context.Context, email string, subject string, content string) error
+}
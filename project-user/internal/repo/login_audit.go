@@ -0,0 +1,11 @@
+package repo
+
+import (
+	"context"
+	"project-user/internal/data/member"
+)
+
+type LoginAuditRepo interface {
+	// SaveAudit 保存登录审计记录
+	SaveAudit(ctx context.Context, audit *member.LoginAudit) error
+}
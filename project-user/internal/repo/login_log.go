@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+	"project-user/internal/data/member"
+)
+
+type LoginLogRepo interface {
+	// SaveLoginLog 保存登录记录
+	SaveLoginLog(ctx context.Context, lg *member.LoginLog) error
+	// FindLoginLogs 分页查询成员的登录记录，按时间倒序
+	FindLoginLogs(ctx context.Context, memId int64, page int64, pageSize int64) ([]*member.LoginLog, int64, error)
+	// FindSessionLogs 查询成员在 since 之后成功登录并签发了令牌的记录，按时间倒序
+	FindSessionLogs(ctx context.Context, memId int64, since int64) ([]*member.LoginLog, error)
+}
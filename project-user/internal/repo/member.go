@@ -15,8 +15,16 @@ type MemberRepo interface {
 	GetMemberByMobile(ctx context.Context, mobile string) (bool, error)
 	// SaveMember 保存会员信息
 	SaveMember(conn database.DbConn, ctx context.Context, mem *member.Member) error
-	// FindMember 根据账号和密码查找会员信息
-	FindMember(ctx context.Context, account string, pwd string) (mem *member.Member, err error)
+	// FindMemberByAccount 根据账号查找会员信息，密码由调用方校验
+	FindMemberByAccount(ctx context.Context, account string) (mem *member.Member, err error)
+	// FindMemberByMobile 根据手机号查找会员信息
+	FindMemberByMobile(ctx context.Context, mobile string) (mem *member.Member, err error)
+	// FindMemberByEmail 根据邮箱查找会员信息
+	FindMemberByEmail(ctx context.Context, email string) (mem *member.Member, err error)
+	// UpdatePassword 更新会员的密码哈希
+	UpdatePassword(ctx context.Context, id int64, pwd string) error
+	// UpdateMember 更新会员的部分字段，values 的键为数据库列名
+	UpdateMember(ctx context.Context, id int64, values map[string]any) error
 	// FindMemberById 根据会员ID查找会员信息
 	FindMemberById(background context.Context, id int64) (mem *member.Member, err error)
 	// FindMemberByIds 根据会员ID列表查找会员信息
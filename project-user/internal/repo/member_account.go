@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+	"project-user/internal/data/member"
+	"project-user/internal/database"
+)
+
+type MemberAccountRepo interface {
+	// FindMemberAccount 查询成员在组织中的账号，不存在时返回nil
+	FindMemberAccount(ctx context.Context, orgId int64, memId int64) (*member.MemberAccount, error)
+	// FindMemberAccountsByMemId 查询成员在所有组织中的账号
+	FindMemberAccountsByMemId(ctx context.Context, memId int64) ([]*member.MemberAccount, error)
+	// SaveMemberAccount 保存成员账号
+	SaveMemberAccount(conn database.DbConn, ctx context.Context, ma *member.MemberAccount) error
+	// UpdateOwner 修改成员账号的拥有者标记和角色
+	UpdateOwner(conn database.DbConn, ctx context.Context, id int64, isOwner int, authorize string) error
+	// UpdateByMemId 同步成员在所有组织中账号的冗余字段（姓名、手机号、邮箱、头像）
+	UpdateByMemId(ctx context.Context, memId int64, values map[string]any) error
+	// DeleteMemberAccount 删除成员在组织中的账号
+	DeleteMemberAccount(ctx context.Context, orgId int64, memId int64) error
+}
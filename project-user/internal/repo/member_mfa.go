@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+	"project-user/internal/data/member"
+)
+
+type MemberMfaRepo interface {
+	// FindMfaByMemId 根据成员id查询两步验证配置
+	FindMfaByMemId(ctx context.Context, memId int64) (*member.MemberMfa, error)
+	// SaveMfa 保存两步验证配置，id为0时新增
+	SaveMfa(ctx context.Context, mfa *member.MemberMfa) error
+	// DeleteMfa 删除成员的两步验证配置
+	DeleteMfa(ctx context.Context, memId int64) error
+}
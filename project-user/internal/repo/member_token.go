@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+	"project-user/internal/data/member"
+)
+
+type MemberTokenRepo interface {
+	// SaveToken 保存个人访问令牌
+	SaveToken(ctx context.Context, token *member.MemberToken) error
+	// FindTokenByHash 根据令牌哈希查询个人访问令牌
+	FindTokenByHash(ctx context.Context, hash string) (*member.MemberToken, error)
+	// FindTokensByMemId 查询成员所有未吊销的个人访问令牌
+	FindTokensByMemId(ctx context.Context, memId int64) ([]*member.MemberToken, error)
+	// RevokeToken 吊销成员的个人访问令牌
+	RevokeToken(ctx context.Context, memId int64, id int64) (bool, error)
+	// UpdateLastUsed 更新令牌最后一次使用的时间和ip
+	UpdateLastUsed(ctx context.Context, id int64, time int64, ip string) error
+}
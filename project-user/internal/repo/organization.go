@@ -11,4 +11,10 @@ type OrganizationRepo interface {
 	SaveOrganization(conn database.DbConn, ctx context.Context, org *organization.Organization) error
 	// FindOrganizationByMemId 根据成员id查询组织
 	FindOrganizationByMemId(ctx context.Context, memId int64) ([]*organization.Organization, error)
+	// FindOrganizationById 根据id查询组织，不存在时返回nil
+	FindOrganizationById(ctx context.Context, id int64) (*organization.Organization, error)
+	// FindOrganizationByIds 根据id列表查询组织
+	FindOrganizationByIds(ctx context.Context, ids []int64) ([]*organization.Organization, error)
+	// UpdateOwner 修改组织的拥有者
+	UpdateOwner(conn database.DbConn, ctx context.Context, id int64, memId int64) error
 }
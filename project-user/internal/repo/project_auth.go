@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"context"
+	"project-user/internal/data/organization"
+	"project-user/internal/database"
+)
+
+type ProjectAuthRepo interface {
+	// FindAuthByType 查询组织中指定类型的角色，不存在时返回nil
+	FindAuthByType(ctx context.Context, orgId int64, authType string) (*organization.ProjectAuth, error)
+	// SaveProjectAuth 保存角色
+	SaveProjectAuth(conn database.DbConn, ctx context.Context, auth *organization.ProjectAuth) error
+}
@@ -0,0 +1,8 @@
+package repo
+
+import "context"
+
+type SmsSender interface {
+	// Send 向手机号发送短信
+	Send(ctx context.Context, mobile string, content string) error
+}
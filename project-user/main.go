@@ -3,15 +3,25 @@ package main
 import (
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"log"
 	srv "project-common"
+	"project-common/metrics"
+	"project-common/tracing"
 	"project-user/config"
 	"project-user/router"
 )
 
 func main() {
 	r := gin.Default()
+	// 初始化链路追踪
+	stopTrace, err := tracing.Init(config.C.SC.Name, config.C.Trace)
+	if err != nil {
+		log.Fatalln(err)
+	}
 	//路由
 	router.InitRouter(r)
+	// Prometheus 指标
+	r.GET("/metrics", metrics.Handler())
 	//grpc服务注册
 	gc := router.RegisterGrpc()
 	fmt.Println("grpc服务注册成功")
@@ -19,6 +29,7 @@ func main() {
 	router.RegisterEtcdServer()
 	stop := func() {
 		gc.Stop()
+		stopTrace()
 	}
 	srv.Run(r, config.C.SC.Name, config.C.SC.Addr, stop)
 }
@@ -1,7 +1,25 @@
 package model
 
 var (
-	Normal         = 1
-	Personal int32 = 1
-	AESKey         = "sdfgyrhgbxcdgryfhgywertd"
+	Normal            = 1
+	Personal    int32 = 1
+	AESKey            = "sdfgyrhgbxcdgryfhgywertd"
+	MfaPending        = 0
+	MfaEnabled        = 1
+	MfaIssuer         = "msproject"
+	PatScheme         = "token "
+	PatPrefix         = "pat_"
+	AuditLock         = "lock"
+	AuditUnlock       = "unlock"
+	// 登录方式
+	LoginMethodPassword = "password"
+	LoginMethodMfa      = "mfa"
+	LoginMethodSwitch   = "switch_org"
+	AuthTypeAdmin       = "admin"
+	AuthTypeMember      = "member"
+	// InviteExpireHours 组织邀请码默认的有效期，MaxInviteExpireHours 为可以设置的最长有效期
+	InviteExpireHours    int64 = 72
+	MaxInviteExpireHours int64 = 720
+	// PatScopes 个人访问令牌可以申请的授权范围，* 表示全部
+	PatScopes = []string{"*", "project", "task", "account", "organization"}
 )
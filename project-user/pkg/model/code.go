@@ -15,4 +15,32 @@ var (
 	AccountExist       = errs.NewError(10102005, "账号已经存在了")
 	MobileExist        = errs.NewError(10102006, "手机号已经存在了")
 	AccountAndPwdError = errs.NewError(10102007, "账号密码不正确")
+	RefreshTokenError  = errs.NewError(10102008, "刷新令牌无效或已过期")
+	RefreshTokenReused = errs.NewError(10102009, "刷新令牌已被使用，请重新登录")
+	PasswordHashError  = errs.NewError(10102010, "密码加密失败")
+	CaptchaTooFrequent = errs.NewError(10102011, "验证码发送过于频繁，请稍后再试")
+	CaptchaBurned      = errs.NewError(10102012, "验证码错误次数过多，请重新获取")
+	NoLegalAccount     = errs.NewError(10102013, "手机号或邮箱不合法")
+	MemberNotExist     = errs.NewError(10102014, "账号不存在")
+	MfaAlreadyEnabled  = errs.NewError(10102015, "已经开启了两步验证")
+	MfaNotEnabled      = errs.NewError(10102016, "没有开启两步验证")
+	MfaCodeError       = errs.NewError(10102017, "动态码错误")
+	MfaTicketError     = errs.NewError(10102018, "登录票据无效或已过期，请重新登录")
+	MfaError           = errs.NewError(10102019, "两步验证处理失败")
+	AccessTokenError   = errs.NewError(10102020, "访问令牌无效、已过期或已被吊销")
+	AccessTokenParam   = errs.NewError(10102021, "访问令牌名称和授权范围不能为空")
+	AccessTokenNoExist = errs.NewError(10102022, "访问令牌不存在")
+	AccountLocked      = errs.NewError(10102023, "密码错误次数过多，账号已被临时锁定，请稍后再试")
+	LoginTooFrequent   = errs.NewError(10102024, "登录失败次数过多，请稍后再试")
+	NoPermission       = errs.NewError(10102025, "没有操作权限")
+	NotOrgMember       = errs.NewError(10102026, "不是该组织的成员")
+	OrgNameEmpty       = errs.NewError(10102027, "组织名称不能为空")
+	PersonalOrgDeny    = errs.NewError(10102028, "个人组织不能邀请成员或转让")
+	InviteError        = errs.NewError(10102029, "邀请码无效或已过期")
+	AlreadyOrgMember   = errs.NewError(10102030, "已经是该组织的成员")
+	OrgOwnerDeny       = errs.NewError(10102031, "不能移除组织的拥有者")
+	OldPasswordError   = errs.NewError(10102032, "原密码不正确")
+	ProfileParamError  = errs.NewError(10102033, "个人资料参数不合法")
+	SessionNotExist    = errs.NewError(10102034, "会话不存在或已失效")
+	JwksError          = errs.NewError(10102035, "获取令牌公钥失败")
 )
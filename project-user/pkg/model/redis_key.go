@@ -2,6 +2,24 @@ package model
 
 var (
 	RegisterRedisKey   = "REGISTER_"
+	ResetPwdRedisKey   = "RESET_PASSWORD_"
+	ChangeRedisKey     = "CHANGE_CONTACT_"
 	Member             = "MEMBER"
 	MemberOrganization = "MEMBER_ORGANIZATION"
+	RefreshToken       = "REFRESH_TOKEN"
+	TokenFamily        = "TOKEN_FAMILY"
+	RevokedToken       = "REVOKED_TOKEN"
+	TokenVersion       = "TOKEN_VERSION"
+	CaptchaAttempts    = "CAPTCHA_ATTEMPTS"
+	CaptchaSendLimit   = "CAPTCHA_SEND_LIMIT"
+	MfaTicket          = "MFA_TICKET"
+	MfaTicketAttempts  = "MFA_TICKET_ATTEMPTS"
+	MfaUsedStep        = "MFA_USED_STEP"
+	LoginFailAccount   = "LOGIN_FAIL_ACCOUNT"
+	LoginFailIp        = "LOGIN_FAIL_IP"
+	LoginIpBackoff     = "LOGIN_IP_BACKOFF"
+	LoginLocked        = "LOGIN_LOCKED"
+	OrgInvite          = "ORG_INVITE"
+	// AuthInvalidate 成员信息、组织或令牌吊销变化时发布成员id，网关收到后丢弃该成员的令牌校验缓存
+	AuthInvalidate = "AUTH_INVALIDATE"
 )
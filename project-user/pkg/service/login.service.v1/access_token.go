@@ -0,0 +1,127 @@
+package login_service_v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/jinzhu/copier"
+	"go.uber.org/zap"
+	"project-common/encrypts"
+	"project-common/errs"
+	"project-common/logs"
+	"project-common/tms"
+	"project-grpc/user/login"
+	"project-user/internal/data/member"
+	"project-user/pkg/model"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CreateAccessToken 创建个人访问令牌。
+// 数据库中只保存令牌的哈希，令牌明文只在本次响应中返回，之后无法再次查看。
+func (ls *LoginService) CreateAccessToken(ctx context.Context, msg *login.AccessTokenMessage) (*login.AccessTokenResponse, error) {
+	c := ctx
+	name := strings.TrimSpace(msg.Name)
+	if name == "" || len(msg.Scopes) == 0 {
+		return nil, errs.GrpcError(model.AccessTokenParam)
+	}
+	for _, scope := range msg.Scopes {
+		if !slices.Contains(model.PatScopes, scope) {
+			return nil, errs.GrpcError(model.AccessTokenParam)
+		}
+	}
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		logs.Ctx(ctx).Error("CreateAccessToken rand error", zap.Error(err))
+		return nil, errs.GrpcError(model.AccessTokenError)
+	}
+	value := model.PatPrefix + hex.EncodeToString(b)
+	now := time.Now()
+	token := &member.MemberToken{
+		MemberId:    msg.MemId,
+		Name:        name,
+		TokenHash:   encrypts.Sha256(value),
+		TokenPrefix: value[:len(model.PatPrefix)+6],
+		Scopes:      strings.Join(msg.Scopes, ","),
+		CreateTime:  now.UnixMilli(),
+	}
+	if msg.ExpireDays > 0 {
+		token.ExpireTime = now.AddDate(0, 0, int(msg.ExpireDays)).UnixMilli()
+	}
+	if err := ls.memberTokenRepo.SaveToken(c, token); err != nil {
+		logs.Ctx(ctx).Error("CreateAccessToken db SaveToken error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	return &login.AccessTokenResponse{Token: value, Info: toAccessTokenInfo(token)}, nil
+}
+
+// ListAccessTokens 查询当前用户未吊销的个人访问令牌
+func (ls *LoginService) ListAccessTokens(ctx context.Context, msg *login.UserMessage) (*login.AccessTokenList, error) {
+	list, err := ls.memberTokenRepo.FindTokensByMemId(ctx, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("ListAccessTokens db FindTokensByMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	var infos []*login.AccessTokenInfo
+	for _, v := range list {
+		infos = append(infos, toAccessTokenInfo(v))
+	}
+	return &login.AccessTokenList{List: infos}, nil
+}
+
+// RevokeAccessToken 吊销个人访问令牌，吊销后立即失效
+func (ls *LoginService) RevokeAccessToken(ctx context.Context, msg *login.AccessTokenMessage) (*login.RevokeAccessTokenResponse, error) {
+	id := encrypts.DecryptNoErr(msg.Code)
+	ok, err := ls.memberTokenRepo.RevokeToken(ctx, msg.MemId, id)
+	if err != nil {
+		logs.Ctx(ctx).Error("RevokeAccessToken db RevokeToken error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if !ok {
+		return nil, errs.GrpcError(model.AccessTokenNoExist)
+	}
+	return &login.RevokeAccessTokenResponse{}, nil
+}
+
+// verifyAccessToken 校验个人访问令牌，并记录最后一次使用的时间和ip
+func (ls *LoginService) verifyAccessToken(ctx context.Context, value string, ip string) (*member.MemberToken, error) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, model.PatPrefix) {
+		return nil, errs.GrpcError(model.NoLogin)
+	}
+	token, err := ls.memberTokenRepo.FindTokenByHash(ctx, encrypts.Sha256(value))
+	if err != nil {
+		logs.Ctx(ctx).Error("verifyAccessToken db FindTokenByHash error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	now := time.Now().UnixMilli()
+	if token == nil || token.Revoked != 0 || (token.ExpireTime > 0 && token.ExpireTime <= now) {
+		return nil, errs.GrpcError(model.AccessTokenError)
+	}
+	// 请求返回后 gRPC 会取消 ctx，异步更新使用不随请求取消的 ctx，保留链路信息
+	go func() {
+		c, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		if err := ls.memberTokenRepo.UpdateLastUsed(c, token.Id, now, ip); err != nil {
+			logs.Ctx(c).Error("verifyAccessToken db UpdateLastUsed error", zap.Error(err))
+		}
+	}()
+	return token, nil
+}
+
+// toAccessTokenInfo 将个人访问令牌转换为展示用的消息，不包含令牌明文和哈希
+func toAccessTokenInfo(token *member.MemberToken) *login.AccessTokenInfo {
+	info := &login.AccessTokenInfo{}
+	copier.Copy(info, token)
+	info.Code = encrypts.EncryptNoErr(token.Id)
+	info.Scopes = token.ScopeList()
+	info.CreateTime = tms.FormatByMill(token.CreateTime)
+	if token.ExpireTime > 0 {
+		info.ExpireTime = tms.FormatByMill(token.ExpireTime)
+	}
+	if token.LastUsedTime > 0 {
+		info.LastUsedTime = tms.FormatByMill(token.LastUsedTime)
+	}
+	return info
+}
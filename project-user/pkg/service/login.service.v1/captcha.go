@@ -0,0 +1,147 @@
+package login_service_v1
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"math/big"
+	"project-common/errs"
+	"project-common/logs"
+	"project-user/config"
+	"project-user/pkg/model"
+	"strings"
+	"time"
+)
+
+// sendCaptcha 生成验证码，存入缓存后通过短信或邮件发送。
+// keyPrefix 区分验证码的用途（注册、找回密码等），同一个手机号不同用途的验证码互不影响；
+// mobile 为邮箱地址时通过邮件发送。
+func (ls *LoginService) sendCaptcha(ctx context.Context, keyPrefix string, mobile string, ip string) (string, error) {
+	cc := config.C.CaptchaConfig
+	// 1. 发送频率限制（手机号和ip两个维度）
+	if err := ls.checkSendLimit(ctx, mobile, ip); err != nil {
+		return "", err
+	}
+	// 2. 生成6位随机验证码
+	code, err := generateCode(6)
+	if err != nil {
+		logs.Ctx(ctx).Error("sendCaptcha generateCode error", zap.Error(err))
+		return "", errs.GrpcError(model.CaptchaError)
+	}
+	// 3. 存储验证码，重新发送会覆盖旧的验证码并重置尝试次数
+	expire := time.Duration(cc.Expire) * time.Minute
+	if err = ls.cache.Put(ctx, keyPrefix+mobile, code, expire); err != nil {
+		logs.Ctx(ctx).Error("sendCaptcha cache put error", zap.Error(err))
+		return "", errs.GrpcError(model.RedisError)
+	}
+	if _, err = ls.cache.Del(ctx, model.CaptchaAttempts+"::"+keyPrefix+mobile); err != nil {
+		logs.Ctx(ctx).Error("sendCaptcha cache del attempts error", zap.Error(err))
+		return "", errs.GrpcError(model.RedisError)
+	}
+	// 4. 调用短信平台（放入go协程中执行 接口可以快速响应）
+	go func() {
+		c, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		content := fmt.Sprintf("您的验证码是%s，%d分钟内有效，请勿泄露给他人。", code, cc.Expire)
+		var err error
+		if strings.Contains(mobile, "@") {
+			err = ls.emailSender.SendEmail(c, mobile, "验证码", content)
+		} else {
+			err = ls.smsSender.Send(c, mobile, content)
+		}
+		if err != nil {
+			logs.Ctx(c).Error("sendCaptcha sms send error", zap.String("mobile", mobile), zap.Error(err))
+		}
+	}()
+	return code, nil
+}
+
+// checkSendLimit 检查手机号和ip的发送频率是否超过限制
+func (ls *LoginService) checkSendLimit(ctx context.Context, mobile string, ip string) error {
+	cc := config.C.CaptchaConfig
+	limits := []struct {
+		key    string
+		limit  int64
+		window time.Duration
+		byIp   bool
+	}{
+		{"mobile::" + mobile + "::minute", cc.MobilePerMinute, time.Minute, false},
+		{"mobile::" + mobile + "::day", cc.MobilePerDay, 24 * time.Hour, false},
+		{"ip::" + ip + "::minute", cc.IpPerMinute, time.Minute, true},
+		{"ip::" + ip + "::day", cc.IpPerDay, 24 * time.Hour, true},
+	}
+	for _, l := range limits {
+		if l.limit <= 0 || (l.byIp && ip == "") {
+			continue
+		}
+		over, err := ls.overLimit(ctx, model.CaptchaSendLimit+"::"+l.key, l.limit, l.window)
+		if err != nil {
+			logs.Ctx(ctx).Error("checkSendLimit cache error", zap.Error(err))
+			return errs.GrpcError(model.RedisError)
+		}
+		if over {
+			return errs.GrpcError(model.CaptchaTooFrequent)
+		}
+	}
+	return nil
+}
+
+// overLimit 在固定窗口内计数，超过 limit 返回true，窗口从第一次计数开始
+func (ls *LoginService) overLimit(ctx context.Context, key string, limit int64, window time.Duration) (bool, error) {
+	n, err := ls.incrWindow(ctx, key, window)
+	if err != nil {
+		return false, err
+	}
+	return n > limit, nil
+}
+
+// incrWindow 在固定窗口内计数并返回当前次数，窗口从第一次计数开始
+func (ls *LoginService) incrWindow(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return ls.cache.IncrWindow(ctx, key, window)
+}
+
+// verifyCaptcha 校验验证码。
+// 每个验证码只能尝试 MaxAttempts 次，超过后验证码作废；校验通过后验证码立即失效，不能重复使用。
+func (ls *LoginService) verifyCaptcha(ctx context.Context, keyPrefix string, mobile string, captcha string) error {
+	key := keyPrefix + mobile
+	redisCode, err := ls.cache.Get(ctx, key)
+	if err == redis.Nil {
+		return errs.GrpcError(model.CaptchaNotExist)
+	}
+	if err != nil {
+		logs.Ctx(ctx).Error("verifyCaptcha redis get error", zap.Error(err))
+		return errs.GrpcError(model.RedisError)
+	}
+	attemptsKey := model.CaptchaAttempts + "::" + key
+	over, err := ls.overLimit(ctx, attemptsKey, config.C.CaptchaConfig.MaxAttempts, time.Duration(config.C.CaptchaConfig.Expire)*time.Minute)
+	if err != nil {
+		logs.Ctx(ctx).Error("verifyCaptcha redis incr error", zap.Error(err))
+		return errs.GrpcError(model.RedisError)
+	}
+	if over {
+		ls.cache.Del(ctx, key, attemptsKey)
+		return errs.GrpcError(model.CaptchaBurned)
+	}
+	if redisCode != captcha {
+		return errs.GrpcError(model.CaptchaError)
+	}
+	if _, err = ls.cache.Del(ctx, key, attemptsKey); err != nil {
+		logs.Ctx(ctx).Error("verifyCaptcha redis del error", zap.Error(err))
+	}
+	return nil
+}
+
+// generateCode 生成指定位数的随机数字验证码
+func generateCode(n int) (string, error) {
+	code := make([]byte, n)
+	for i := range code {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		code[i] = byte('0' + d.Int64())
+	}
+	return string(code), nil
+}
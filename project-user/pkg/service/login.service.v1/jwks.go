@@ -0,0 +1,24 @@
+package login_service_v1
+
+import (
+	"context"
+	"encoding/json"
+	"go.uber.org/zap"
+	"project-common/errs"
+	"project-common/logs"
+	"project-grpc/user/login"
+	"project-user/config"
+	"project-user/pkg/model"
+)
+
+// Jwks 发布校验访问令牌的公钥（JWKS文档）。
+// 只有用户服务持有私钥，网关等其他服务通过该接口获取公钥在本地校验令牌，
+// 轮换期间新旧密钥的公钥都会包含在文档中。
+func (ls *LoginService) Jwks(ctx context.Context, msg *login.JwksMessage) (*login.JwksResponse, error) {
+	data, err := json.Marshal(config.C.JwtConfig.KeySet.JWKS())
+	if err != nil {
+		logs.Ctx(ctx).Error("Jwks json marshal error", zap.Error(err))
+		return nil, errs.GrpcError(model.JwksError)
+	}
+	return &login.JwksResponse{Jwks: string(data)}, nil
+}
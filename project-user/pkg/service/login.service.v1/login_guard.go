@@ -0,0 +1,209 @@
+package login_service_v1
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"project-common/encrypts"
+	"project-common/errs"
+	"project-common/logs"
+	"project-grpc/user/login"
+	"project-user/config"
+	"project-user/internal/data/member"
+	"project-user/pkg/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ipFailWindow 按ip统计登录失败次数的窗口
+const ipFailWindow = time.Hour
+
+// UnlockMember 管理员解除成员账号的登录锁定。
+// 操作人必须是组织的拥有者或管理员，被解锁的成员必须属于该组织。
+func (ls *LoginService) UnlockMember(ctx context.Context, msg *login.UnlockMemberMessage) (*login.UnlockMemberResponse, error) {
+	c := ctx
+	orgId := encrypts.DecryptNoErr(msg.OrganizationCode)
+	isAdmin, err := ls.isOrgAdmin(c, orgId, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("UnlockMember isOrgAdmin error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if !isAdmin {
+		return nil, errs.GrpcError(model.NoPermission)
+	}
+	mem, err := ls.memberRepo.FindMemberByAccount(c, msg.Account)
+	if err != nil {
+		logs.Ctx(ctx).Error("UnlockMember db FindMemberByAccount error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if mem == nil {
+		return nil, errs.GrpcError(model.MemberNotExist)
+	}
+	ma, err := ls.memberAccountRepo.FindMemberAccount(c, orgId, mem.Id)
+	if err != nil {
+		logs.Ctx(ctx).Error("UnlockMember db FindMemberAccount error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if ma == nil {
+		return nil, errs.GrpcError(model.NoPermission)
+	}
+	key := loginAccountKey(msg.Account)
+	if _, err = ls.cache.Del(c, model.LoginLocked+"::"+key, model.LoginFailAccount+"::"+key); err != nil {
+		logs.Ctx(ctx).Error("UnlockMember cache del error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	ls.saveAudit(c, &member.LoginAudit{
+		MemberId:   mem.Id,
+		Account:    msg.Account,
+		Event:      model.AuditUnlock,
+		Detail:     "管理员解除锁定",
+		OperatorId: msg.MemId,
+	})
+	return &login.UnlockMemberResponse{}, nil
+}
+
+// checkLoginAllowed 检查ip是否处于退避期、账号是否被锁定
+func (ls *LoginService) checkLoginAllowed(ctx context.Context, account string, ip string) error {
+	if ip != "" {
+		_, err := ls.cache.Get(ctx, model.LoginIpBackoff+"::"+ip)
+		if err == nil {
+			return errs.GrpcError(model.LoginTooFrequent)
+		}
+		if err != redis.Nil {
+			logs.Ctx(ctx).Error("checkLoginAllowed cache get ip backoff error", zap.Error(err))
+			return errs.GrpcError(model.RedisError)
+		}
+	}
+	_, err := ls.cache.Get(ctx, model.LoginLocked+"::"+loginAccountKey(account))
+	if err == nil {
+		return errs.GrpcError(model.AccountLocked)
+	}
+	if err != redis.Nil {
+		logs.Ctx(ctx).Error("checkLoginAllowed cache get account locked error", zap.Error(err))
+		return errs.GrpcError(model.RedisError)
+	}
+	return nil
+}
+
+// loginFailed 记录一次登录失败，返回给调用方的错误
+func (ls *LoginService) loginFailed(ctx context.Context, account string, memId int64, ip string) error {
+	locked, err := ls.recordFailure(ctx, account, memId, ip)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return errs.GrpcError(model.AccountLocked)
+	}
+	return errs.GrpcError(model.AccountAndPwdError)
+}
+
+// recordFailure 记录一次密码校验失败，返回账号是否因此被锁定。
+// 账号在统计窗口内失败次数达到阈值后被锁定；ip超过免费次数后每次失败的退避时长翻倍。
+func (ls *LoginService) recordFailure(ctx context.Context, account string, memId int64, ip string) (bool, error) {
+	lc := config.C.LoginConfig
+	key := loginAccountKey(account)
+	n, err := ls.incrWindow(ctx, model.LoginFailAccount+"::"+key, time.Duration(lc.FailWindow)*time.Minute)
+	if err != nil {
+		logs.Ctx(ctx).Error("loginFailed cache incr account error", zap.Error(err))
+		return false, errs.GrpcError(model.RedisError)
+	}
+	locked := false
+	if lc.MaxFailures > 0 && n >= lc.MaxFailures {
+		lock := time.Duration(lc.LockMinutes) * time.Minute
+		if err = ls.cache.Put(ctx, model.LoginLocked+"::"+key, ip, lock); err != nil {
+			logs.Ctx(ctx).Error("loginFailed cache put account locked error", zap.Error(err))
+			return false, errs.GrpcError(model.RedisError)
+		}
+		ls.cache.Del(ctx, model.LoginFailAccount+"::"+key)
+		ls.saveAudit(ctx, &member.LoginAudit{
+			MemberId: memId,
+			Account:  account,
+			Ip:       ip,
+			Event:    model.AuditLock,
+			Detail:   fmt.Sprintf("连续%d次登录失败，锁定%d分钟", n, lc.LockMinutes),
+		})
+		locked = true
+	}
+	if ip != "" {
+		m, err := ls.incrWindow(ctx, model.LoginFailIp+"::"+ip, ipFailWindow)
+		if err != nil {
+			logs.Ctx(ctx).Error("loginFailed cache incr ip error", zap.Error(err))
+			return false, errs.GrpcError(model.RedisError)
+		}
+		if backoff := ipBackoff(m, lc); backoff > 0 {
+			if err = ls.cache.Put(ctx, model.LoginIpBackoff+"::"+ip, account, backoff); err != nil {
+				logs.Ctx(ctx).Error("loginFailed cache put ip backoff error", zap.Error(err))
+				return false, errs.GrpcError(model.RedisError)
+			}
+		}
+	}
+	return locked, nil
+}
+
+// clearLoginFailures 登录成功后清除账号和ip的失败计数
+func (ls *LoginService) clearLoginFailures(ctx context.Context, account string, ip string) {
+	keys := []string{model.LoginFailAccount + "::" + loginAccountKey(account)}
+	if ip != "" {
+		keys = append(keys, model.LoginFailIp+"::"+ip)
+	}
+	if _, err := ls.cache.Del(ctx, keys...); err != nil {
+		logs.Ctx(ctx).Error("clearLoginFailures cache del error", zap.Error(err))
+	}
+}
+
+// isOrgAdmin 判断成员是否为组织的拥有者或持有组织的管理员角色
+func (ls *LoginService) isOrgAdmin(ctx context.Context, orgId int64, memId int64) (bool, error) {
+	org, err := ls.organizationRepo.FindOrganizationById(ctx, orgId)
+	if err != nil || org == nil {
+		return false, err
+	}
+	if org.MemberId == memId {
+		return true, nil
+	}
+	ma, err := ls.memberAccountRepo.FindMemberAccount(ctx, orgId, memId)
+	if err != nil || ma == nil {
+		return false, err
+	}
+	if ma.IsOwner == 1 {
+		return true, nil
+	}
+	if ma.Authorize == "" {
+		return false, nil
+	}
+	adminAuth, err := ls.projectAuthRepo.FindAuthByType(ctx, orgId, model.AuthTypeAdmin)
+	if err != nil || adminAuth == nil {
+		return false, err
+	}
+	return ma.Authorize == strconv.FormatInt(adminAuth.Id, 10), nil
+}
+
+// saveAudit 写入登录审计记录，写入失败只记录日志，不影响主流程
+func (ls *LoginService) saveAudit(ctx context.Context, audit *member.LoginAudit) {
+	audit.CreateTime = time.Now().UnixMilli()
+	if err := ls.loginAuditRepo.SaveAudit(ctx, audit); err != nil {
+		logs.Ctx(ctx).Error("saveAudit db SaveAudit error", zap.String("event", audit.Event), zap.Error(err))
+	}
+}
+
+// ipBackoff 计算ip第n次失败后的退避时长，未超过免费次数时返回0
+func ipBackoff(n int64, lc *config.LoginConfig) time.Duration {
+	over := n - lc.IpFreeFailures
+	if over <= 0 || lc.IpBackoffBase <= 0 {
+		return 0
+	}
+	backoff := lc.IpBackoffBase
+	for i := int64(1); i < over && backoff < lc.IpBackoffMax; i++ {
+		backoff *= 2
+	}
+	if lc.IpBackoffMax > 0 && backoff > lc.IpBackoffMax {
+		backoff = lc.IpBackoffMax
+	}
+	return time.Duration(backoff) * time.Second
+}
+
+// loginAccountKey 账号锁定使用的缓存键，忽略大小写和首尾空格
+func loginAccountKey(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
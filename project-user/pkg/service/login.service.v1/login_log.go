@@ -0,0 +1,127 @@
+package login_service_v1
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+	"project-common/errs"
+	"project-common/jwts"
+	"project-common/logs"
+	"project-common/tms"
+	"project-grpc/user/login"
+	"project-user/config"
+	"project-user/internal/data/member"
+	"project-user/pkg/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LoginHistory 分页查询我的登录记录
+func (ls *LoginService) LoginHistory(ctx context.Context, msg *login.LoginLogMessage) (*login.LoginLogResponse, error) {
+	page, pageSize := msg.Page, msg.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+	list, total, err := ls.loginLogRepo.FindLoginLogs(ctx, msg.MemId, page, pageSize)
+	if err != nil {
+		logs.Ctx(ctx).Error("LoginHistory db FindLoginLogs error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	infos := make([]*login.LoginLogInfo, 0, len(list))
+	for _, v := range list {
+		infos = append(infos, &login.LoginLogInfo{
+			Ip:         v.Ip,
+			UserAgent:  v.UserAgent,
+			Method:     v.Method,
+			Success:    v.Success == 1,
+			Reason:     v.Reason,
+			CreateTime: tms.FormatByMill(v.CreateTime),
+		})
+	}
+	return &login.LoginLogResponse{List: infos, Total: total}, nil
+}
+
+// ActiveSessions 查询我的活跃会话。
+// 每次登录签发一个令牌家族，家族在缓存中仍然有效的登录记录就是活跃会话。
+func (ls *LoginService) ActiveSessions(ctx context.Context, msg *login.LoginLogMessage) (*login.SessionListResponse, error) {
+	c := ctx
+	since := time.Now().Add(-time.Duration(config.C.JwtConfig.RefreshExp*3600*24) * time.Second).UnixMilli()
+	list, err := ls.loginLogRepo.FindSessionLogs(c, msg.MemId, since)
+	if err != nil {
+		logs.Ctx(ctx).Error("ActiveSessions db FindSessionLogs error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	current := currentFamily(msg.Token)
+	sessions := make([]*login.SessionInfo, 0)
+	for _, v := range list {
+		alive, err := ls.tokenFamily.FamilyAlive(c, v.Family)
+		if err != nil {
+			logs.Ctx(ctx).Error("ActiveSessions familyAlive error", zap.Error(err))
+			return nil, errs.GrpcError(model.RedisError)
+		}
+		if !alive {
+			continue
+		}
+		sessions = append(sessions, &login.SessionInfo{
+			Code:       v.Family,
+			Ip:         v.Ip,
+			UserAgent:  v.UserAgent,
+			Method:     v.Method,
+			CreateTime: tms.FormatByMill(v.CreateTime),
+			Current:    v.Family == current,
+		})
+	}
+	return &login.SessionListResponse{List: sessions}, nil
+}
+
+// RevokeSession 吊销我的一个会话，例如在其他设备上的登录。
+// 会话对应的令牌家族作废后，TokenVerify 会拒绝该会话的访问令牌，刷新令牌也不能再使用。
+func (ls *LoginService) RevokeSession(ctx context.Context, msg *login.LoginLogMessage) (*login.RevokeSessionResponse, error) {
+	c := ctx
+	key := model.TokenFamily + "::" + msg.SessionCode
+	memIdStr, err := ls.cache.Get(c, key)
+	if err == redis.Nil || (err == nil && memIdStr != strconv.FormatInt(msg.MemId, 10)) {
+		return nil, errs.GrpcError(model.SessionNotExist)
+	}
+	if err != nil {
+		logs.Ctx(ctx).Error("RevokeSession cache get family error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	if _, err = ls.cache.Del(c, key); err != nil {
+		logs.Ctx(ctx).Error("RevokeSession cache del family error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	ls.invalidateMember(c, memIdStr)
+	return &login.RevokeSessionResponse{}, nil
+}
+
+// saveLoginLog 写入登录记录，err 为登录失败的原因，原样返回方便调用方直接返回。
+// 写入失败只记录日志，不影响登录结果。
+func (ls *LoginService) saveLoginLog(ctx context.Context, lg *member.LoginLog, err error) error {
+	lg.CreateTime = time.Now().UnixMilli()
+	lg.Success = 1
+	if err != nil {
+		lg.Success = 0
+		lg.Family = ""
+		lg.Reason = status.Convert(err).Message()
+	}
+	if dbErr := ls.loginLogRepo.SaveLoginLog(ctx, lg); dbErr != nil {
+		logs.Ctx(ctx).Error("saveLoginLog db SaveLoginLog error", zap.Error(dbErr))
+	}
+	return err
+}
+
+// currentFamily 解析发起请求的访问令牌所属的令牌家族，解析失败时返回空
+func currentFamily(token string) string {
+	token = strings.TrimPrefix(token, "bearer ")
+	claims, err := jwts.ParseClaimsWithKeySet(token, config.C.JwtConfig.KeySet)
+	if err != nil {
+		return ""
+	}
+	return claims.Family
+}
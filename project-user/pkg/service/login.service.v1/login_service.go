@@ -3,14 +3,14 @@ package login_service_v1
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 	"go.uber.org/zap"
 	common "project-common"
 	"project-common/encrypts"
 	"project-common/errs"
 	"project-common/jwts"
+	"project-common/logs"
 	"project-common/tms"
 	"project-grpc/user/login"
 	"project-user/config"
@@ -19,6 +19,7 @@ import (
 	"project-user/internal/data/organization"
 	"project-user/internal/database"
 	"project-user/internal/database/tran"
+	"project-user/internal/domain"
 	"project-user/internal/repo"
 	"project-user/pkg/model"
 	"strconv"
@@ -26,14 +27,27 @@ import (
 	"time"
 )
 
+// dummyPasswordHash 账号不存在时用于校验的固定 argon2id 哈希，参数与 DefaultArgon2Params 相同，
+// 使账号不存在和密码错误的响应时间一致
+const dummyPasswordHash = "$argon2id$v=19$m=65536,t=1,p=4$Drhzl6lPDB0fQkkJdJJIJQ$/qggcGMFX0MeH3k72Qq/TmE5or8XFPdVuWM5ZTdMHuo"
+
 // LoginService 提供了登录服务的实现，继承了 login.UnimplementedLoginServiceServer 的方法。
 // 它通过集成缓存、成员仓库、组织仓库和事务处理来实现登录相关的功能。
 type LoginService struct {
-	login.UnimplementedLoginServiceServer                       // 继承自登录服务的未实现方法，为登录服务提供默认实现。
-	cache                                 repo.Cache            // 缓存接口，用于快速存储和检索数据。
-	memberRepo                            repo.MemberRepo       // 成员仓库接口，用于处理与成员相关的数据操作。
-	organizationRepo                      repo.OrganizationRepo // 组织仓库接口，用于处理与组织相关的数据操作。
-	transaction                           tran.Transaction      // 事务处理接口，用于处理需要事务支持的操作。
+	login.UnimplementedLoginServiceServer                           // 继承自登录服务的未实现方法，为登录服务提供默认实现。
+	cache                                 repo.Cache                // 缓存接口，用于快速存储和检索数据。
+	memberRepo                            repo.MemberRepo           // 成员仓库接口，用于处理与成员相关的数据操作。
+	organizationRepo                      repo.OrganizationRepo     // 组织仓库接口，用于处理与组织相关的数据操作。
+	memberMfaRepo                         repo.MemberMfaRepo        // 两步验证仓库接口，用于处理成员的两步验证配置。
+	memberTokenRepo                       repo.MemberTokenRepo      // 个人访问令牌仓库接口，用于处理成员的个人访问令牌。
+	memberAccountRepo                     repo.MemberAccountRepo    // 组织成员账号仓库接口，用于判断成员在组织中的身份。
+	loginAuditRepo                        repo.LoginAuditRepo       // 登录审计仓库接口，用于记录账号锁定等安全事件。
+	projectAuthRepo                       repo.ProjectAuthRepo      // 角色仓库接口，用于初始化和查询组织的默认角色。
+	loginLogRepo                          repo.LoginLogRepo         // 登录记录仓库接口，用于记录登录历史和查询会话。
+	smsSender                             repo.SmsSender            // 短信发送接口，用于发送验证码。
+	emailSender                           repo.EmailSender          // 邮件发送接口，用于发送验证码。
+	transaction                           tran.Transaction          // 事务处理接口，用于处理需要事务支持的操作。
+	tokenFamily                           *domain.TokenFamilyDomain // 令牌家族，用于刷新令牌的轮换和令牌家族的作废。
 }
 
 // New 创建并返回一个新的 LoginService 实例。
@@ -42,14 +56,24 @@ func New() *LoginService {
 	// 返回一个新的 LoginService 实例，并为各个字段赋值。
 	// dao.Rc 提供了缓存的实现，而 NewMemberDao、NewOrganizationDao 和 NewTransaction 分别提供了成员、组织和事务处理的实际实现。
 	return &LoginService{
-		cache:            dao.Rc,
-		memberRepo:       dao.NewMemberDao(),
-		organizationRepo: dao.NewOrganizationDao(),
-		transaction:      dao.NewTransaction(),
+		cache:             dao.Rc,
+		memberRepo:        dao.NewMemberDao(),
+		organizationRepo:  dao.NewOrganizationDao(),
+		memberMfaRepo:     dao.NewMemberMfaDao(),
+		memberTokenRepo:   dao.NewMemberTokenDao(),
+		memberAccountRepo: dao.NewMemberAccountDao(),
+		loginAuditRepo:    dao.NewLoginAuditDao(),
+		projectAuthRepo:   dao.NewProjectAuthDao(),
+		loginLogRepo:      dao.NewLoginLogDao(),
+		smsSender:         dao.NewSmsSender(),
+		emailSender:       dao.NewEmailSender(),
+		transaction:       dao.NewTransaction(),
+		tokenFamily:       domain.NewTokenFamilyDomain(dao.Rc),
 	}
 }
 
 // GetCaptcha 处理获取验证码的请求。
+// 非开发模式下验证码只通过短信发送，不会在响应中返回。
 func (ls *LoginService) GetCaptcha(ctx context.Context, msg *login.CaptchaMessage) (*login.CaptchaResponse, error) {
 	//1.获取参数
 	mobile := msg.Mobile
@@ -57,23 +81,15 @@ func (ls *LoginService) GetCaptcha(ctx context.Context, msg *login.CaptchaMessag
 	if !common.VerifyMobile(mobile) {
 		return nil, errs.GrpcError(model.NoLegalMobile)
 	}
-	//3.生成验证码（随机4位1000-9999或者6位100000-999999）
-	code := "123456"
-	//4.调用短信平台（三方 放入go协程中执行 接口可以快速响应）
-	go func() {
-		time.Sleep(2 * time.Second)
-		zap.L().Info("短信平台调用成功，发送短信")
-		//redis 假设后续缓存可能存在mysql当中，也可能存在mongo当中 也可能存在memcache当中
-		//5.存储验证码 redis当中 过期时间15分钟
-		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-		// 将对应的验证码存储到 Redis 中，并设置过期时间为 15 分钟。
-		err := ls.cache.Put(c, model.RegisterRedisKey+mobile, code, 15*time.Minute)
-		if err != nil {
-			zap.L().Info(fmt.Sprintf("验证码存入redis出错,cause by: %v \n", err))
-		}
-	}()
+	//3.生成验证码 存储到redis 并调用短信平台发送
+	code, err := ls.sendCaptcha(ctx, model.RegisterRedisKey, mobile, msg.Ip)
+	if err != nil {
+		return nil, err
+	}
 	// 返回验证码
+	if !config.C.CaptchaConfig.Dev {
+		code = ""
+	}
 	return &login.CaptchaResponse{Code: code}, nil
 }
 
@@ -82,29 +98,15 @@ func (ls *LoginService) GetCaptcha(ctx context.Context, msg *login.CaptchaMessag
 // 以及将用户信息保存到数据库中，并创建对应的个人组织。
 func (ls *LoginService) Register(ctx context.Context, msg *login.RegisterMessage) (*login.RegisterResponse, error) {
 	// 初始化一个新的上下文对象，用于后续的数据库和缓存操作
-	c := context.Background()
+	c := ctx
 
 	// 1. 可以校验参数
-	// 2. 校验验证码
-	// 从缓存中获取验证码，检查是否存在以及是否匹配用户提交的验证码（是否过期）
-	redisCode, err := ls.cache.Get(c, model.RegisterRedisKey+msg.Mobile)
-	if err == redis.Nil {
-		return nil, errs.GrpcError(model.CaptchaNotExist)
-	}
-	if err != nil {
-		zap.L().Error("Register redis get error", zap.Error(err))
-		return nil, errs.GrpcError(model.RedisError)
-	}
-	// 比较验证码
-	if redisCode != msg.Captcha {
-		return nil, errs.GrpcError(model.CaptchaError)
-	}
-
-	// 3. 校验业务逻辑（邮箱是否被注册 账号是否被注册 手机号是否被注册）
+	// 2. 校验业务逻辑（邮箱是否被注册 账号是否被注册 手机号是否被注册）
+	// 先于验证码校验，信息重复时验证码不会被作废
 	// 检查邮箱、账号和手机号是否已经存在于数据库中
 	exist, err := ls.memberRepo.GetMemberByEmail(c, msg.Email)
 	if err != nil {
-		zap.L().Error("Register db get error", zap.Error(err))
+		logs.Ctx(ctx).Error("Register db get error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if exist {
@@ -113,7 +115,7 @@ func (ls *LoginService) Register(ctx context.Context, msg *login.RegisterMessage
 	// 检查账号是否已经存在于数据库中
 	exist, err = ls.memberRepo.GetMemberByAccount(c, msg.Name)
 	if err != nil {
-		zap.L().Error("Register db get error", zap.Error(err))
+		logs.Ctx(ctx).Error("Register db get error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if exist {
@@ -122,16 +124,26 @@ func (ls *LoginService) Register(ctx context.Context, msg *login.RegisterMessage
 	// 检查手机号是否已经存在于数据库中
 	exist, err = ls.memberRepo.GetMemberByMobile(c, msg.Mobile)
 	if err != nil {
-		zap.L().Error("Register db get error", zap.Error(err))
+		logs.Ctx(ctx).Error("Register db get error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if exist {
 		return nil, errs.GrpcError(model.MobileExist)
 	}
 
+	// 3. 校验验证码
+	// 校验通过后验证码立即作废，错误次数过多时验证码也会作废
+	if err := ls.verifyCaptcha(c, model.RegisterRedisKey, msg.Mobile, msg.Captcha); err != nil {
+		return nil, err
+	}
+
 	// 4. 执行业务 将数据存入member表 生成一个数据 存入组织表 organization
-	// 对用户信息进行加密处理，并保存到数据库中。
-	pwd := encrypts.Md5(msg.Password)
+	// 对密码进行加盐哈希处理，并保存到数据库中。
+	pwd, err := encrypts.HashPassword(msg.Password)
+	if err != nil {
+		logs.Ctx(ctx).Error("Register HashPassword error", zap.Error(err))
+		return nil, errs.GrpcError(model.PasswordHashError)
+	}
 	// 创建一个新的成员对象，并设置相关属性。
 	mem := &member.Member{
 		Account:       msg.Name,
@@ -148,7 +160,7 @@ func (ls *LoginService) Register(ctx context.Context, msg *login.RegisterMessage
 		// 存入member
 		err = ls.memberRepo.SaveMember(conn, c, mem)
 		if err != nil {
-			zap.L().Error("Register db SaveMember error", zap.Error(err))
+			logs.Ctx(ctx).Error("Register db SaveMember error", zap.Error(err))
 			return errs.GrpcError(model.DBError)
 		}
 
@@ -164,7 +176,7 @@ func (ls *LoginService) Register(ctx context.Context, msg *login.RegisterMessage
 		// 调用 OrganizationRepo 的 SaveOrganization 方法将组织信息存入数据库。
 		err = ls.organizationRepo.SaveOrganization(conn, c, org)
 		if err != nil {
-			zap.L().Error("register SaveOrganization db err", zap.Error(err))
+			logs.Ctx(ctx).Error("register SaveOrganization db err", zap.Error(err))
 			return errs.GrpcError(model.DBError)
 		}
 		return nil
@@ -179,35 +191,70 @@ func (ls *LoginService) Register(ctx context.Context, msg *login.RegisterMessage
 // 该方法接收登录信息，验证用户身份，生成并返回登录响应，包括用户信息、组织信息和令牌信息
 func (ls *LoginService) Login(ctx context.Context, msg *login.LoginMessage) (*login.LoginResponse, error) {
 	// 创建一个新的上下文对象，用于后续的数据库查询等操作
-	c := context.Background()
+	c := ctx
 
+	// 每一次登录尝试都会写入登录记录
+	lg := &member.LoginLog{Account: msg.Account, Ip: msg.Ip, UserAgent: msg.UserAgent, Method: model.LoginMethodPassword}
+	// 0. 防暴力破解：ip处于退避期或账号被锁定时直接拒绝，不再校验密码
+	if err := ls.checkLoginAllowed(c, msg.Account, msg.Ip); err != nil {
+		return nil, ls.saveLoginLog(c, lg, err)
+	}
 	// 1. 去数据库查询 账号密码是否正确
-	// 对输入的密码进行MD5加密，以便与数据库中的密码进行比较
-	pwd := encrypts.Md5(msg.Password)
-	// 调用成员仓库的FindMember方法查询数据库中是否存在匹配的用户名和密码
-	mem, err := ls.memberRepo.FindMember(c, msg.Account, pwd)
+	// 按账号查询用户，密码哈希在内存中校验，不再拼接到SQL条件里
+	mem, err := ls.memberRepo.FindMemberByAccount(c, msg.Account)
 	if err != nil {
 		// 如果查询过程中出现错误，记录错误日志并返回数据库错误
-		zap.L().Error("Login db FindMember error", zap.Error(err))
+		logs.Ctx(ctx).Error("Login db FindMemberByAccount error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	if mem == nil {
-		// 如果查询结果为空，说明用户名或密码不正确，返回相应错误
-		return nil, errs.GrpcError(model.AccountAndPwdError)
+		// 如果查询结果为空，说明用户名不存在，返回与密码错误相同的错误，避免泄露账号是否存在
+		// 同样做一次哈希校验，避免通过响应时间判断账号是否存在
+		encrypts.VerifyPassword(dummyPasswordHash, msg.Password)
+		return nil, ls.saveLoginLog(c, lg, ls.loginFailed(c, msg.Account, 0, msg.Ip))
+	}
+	lg.MemberId = mem.Id
+	ok, needRehash := encrypts.VerifyPassword(mem.Password, msg.Password)
+	if !ok {
+		return nil, ls.saveLoginLog(c, lg, ls.loginFailed(c, msg.Account, mem.Id, msg.Ip))
+	}
+	ls.clearLoginFailures(c, msg.Account, msg.Ip)
+	// 旧的md5哈希或者参数过期的哈希，在登录成功后透明地升级
+	if needRehash {
+		ls.rehashPassword(c, mem.Id, msg.Password)
+	}
+	// 开启了两步验证的用户，密码正确后只返回一个短期有效的票据，提交动态码后才能拿到令牌
+	mfa, err := ls.memberMfaRepo.FindMfaByMemId(c, mem.Id)
+	if err != nil {
+		logs.Ctx(ctx).Error("Login db FindMfaByMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
 	}
+	if mfa != nil && mfa.Enabled == model.MfaEnabled {
+		ticket, err := ls.createMfaTicket(c, mem.Id)
+		if err != nil {
+			logs.Ctx(ctx).Error("Login createMfaTicket error", zap.Error(err))
+			return nil, errs.GrpcError(model.RedisError)
+		}
+		return &login.LoginResponse{MfaTicket: ticket}, nil
+	}
+	return ls.loginSuccess(c, mem, lg)
+}
 
+// loginSuccess 身份校验通过后，查询用户的组织信息，签发令牌并生成登录响应。
+// 本次登录开启一个新的令牌家族（会话），并写入登录记录。
+func (ls *LoginService) loginSuccess(c context.Context, mem *member.Member, lg *member.LoginLog) (*login.LoginResponse, error) {
 	// 将查询到的成员信息复制到MemberMessage对象中，并对成员ID进行加密
 	memMsg := &login.MemberMessage{}
-	err = copier.Copy(memMsg, mem)
+	err := copier.Copy(memMsg, mem)
 	memMsg.Code, _ = encrypts.EncryptInt64(mem.Id, model.AESKey)
 	memMsg.LastLoginTime = tms.FormatByMill(mem.LastLoginTime)
 	memMsg.CreateTime = tms.FormatByMill(mem.CreateTime)
 	// 2. 根据用户id查组织
 	// 调用组织仓库的FindOrganizationByMemId方法，根据成员ID查询其所属的组织信息
-	orgs, err := ls.organizationRepo.FindOrganizationByMemId(c, mem.Id)
+	orgs, err := ls.memberOrgs(c, mem.Id)
 	if err != nil {
 		// 如果查询过程中出现错误，记录错误日志并返回数据库错误
-		zap.L().Error("Login db FindMember error", zap.Error(err))
+		logs.Ctx(c).Error("Login db FindMember error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
@@ -220,30 +267,33 @@ func (ls *LoginService) Login(ctx context.Context, msg *login.LoginMessage) (*lo
 		o := organization.ToMap(orgs)[v.Id]
 		v.CreateTime = tms.FormatByMill(o.CreateTime)
 	}
+	var orgId int64
 	if len(orgs) > 0 {
-		memMsg.OrganizationCode, _ = encrypts.EncryptInt64(orgs[0].Id, model.AESKey)
+		orgId = orgs[0].Id
+		memMsg.OrganizationCode, _ = encrypts.EncryptInt64(orgId, model.AESKey)
 	}
 	// 3. 用jwt生成token
 	// 将成员ID转换为字符串，用于生成JWT令牌
 	memIdStr := strconv.FormatInt(mem.Id, 10)
-	// 计算访问令牌和刷新令牌的过期时间
+	// 访问令牌的过期时间，同时作为用户信息缓存的过期时间
 	exp := time.Duration(config.C.JwtConfig.AccessExp*3600*24) * time.Second
-	rExp := time.Duration(config.C.JwtConfig.RefreshExp*3600*24) * time.Second
-	// 生成JWT令牌
-	token := jwts.CreateToken(memIdStr, exp, config.C.JwtConfig.AccessSecret, rExp, config.C.JwtConfig.RefreshSecret, msg.Ip)
-	// 将生成的令牌信息封装到TokenMessage对象中
-	tokenList := &login.TokenMessage{
-		AccessToken:    token.AccessToken,
-		RefreshToken:   token.RefreshToken,
-		AccessTokenExp: token.AccessExp,
-		TokenType:      "bearer",
+	// 生成令牌并登记刷新令牌，开启一个新的令牌家族
+	lg.MemberId = mem.Id
+	lg.Family = uuid.NewString()
+	tokenList, err := ls.createToken(c, memIdStr, lg.Ip, lg.Family, orgId)
+	if err != nil {
+		logs.Ctx(c).Error("Login createToken error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
 	}
+	ls.saveLoginLog(c, lg, nil)
 	//TODO 放入缓存 member orgs
+	// 请求返回后 gRPC 会取消 ctx，异步写缓存使用不随请求取消的 ctx，保留链路信息
 	go func() {
-		marshal, _ := json.Marshal(mem)
-		ls.cache.Put(c, model.Member+"::"+memIdStr, string(marshal), exp)
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(c), 10*time.Second)
+		defer cancel()
+		ls.cacheMember(ctx, mem)
 		orgsJson, _ := json.Marshal(orgs)
-		ls.cache.Put(c, model.MemberOrganization+"::"+memIdStr, string(orgsJson), exp)
+		ls.cache.Put(ctx, model.MemberOrganization+"::"+memIdStr, string(orgsJson), exp)
 	}()
 	// 返回登录响应，包括成员信息、组织信息和令牌信息
 	return &login.LoginResponse{
@@ -253,34 +303,52 @@ func (ls *LoginService) Login(ctx context.Context, msg *login.LoginMessage) (*lo
 	}, nil
 }
 
+// rehashPassword 使用当前的哈希算法重新生成密码哈希并保存，升级失败不影响本次登录
+func (ls *LoginService) rehashPassword(ctx context.Context, memId int64, password string) {
+	pwd, err := encrypts.HashPassword(password)
+	if err != nil {
+		logs.Ctx(ctx).Error("Login rehash HashPassword error", zap.Error(err))
+		return
+	}
+	if err = ls.memberRepo.UpdatePassword(ctx, memId, pwd); err != nil {
+		logs.Ctx(ctx).Error("Login rehash UpdatePassword error", zap.Error(err))
+	}
+}
+
 // TokenVerifyOld 验证用户登录状态（未存入队列）
 // 该方法接收一个LoginMessage，其中包含用户提供的token信息
 // 它会解析token，验证其有效性，并从数据库中获取用户信息
 // 如果验证成功，返回包含用户信息的LoginResponse；如果失败，返回错误
 func (ls *LoginService) TokenVerify(ctx context.Context, msg *login.LoginMessage) (*login.LoginResponse, error) {
-	// 提取token信息，并处理带有bearer前缀的token
-	token := msg.Token
-	if strings.Contains(token, "bearer") {
-		token = strings.ReplaceAll(token, "bearer ", "")
-	}
-
-	// 解析token，验证其有效性
-	parseToken, err := jwts.ParseTokenOld(token, config.C.JwtConfig.AccessSecret)
-	if err != nil {
-		// 如果token验证失败，记录错误日志，并返回登录错误
-		zap.L().Error("Login  TokenVerify error", zap.Error(err))
-		return nil, errs.GrpcError(model.NoLogin)
+	// 个人访问令牌使用 "token <pat>" 的格式，与 "bearer <jwt>" 格式的登录令牌区分
+	var id, orgId int64
+	var scopes []string
+	var err error
+	if strings.HasPrefix(msg.Token, model.PatScheme) {
+		var pat *member.MemberToken
+		pat, err = ls.verifyAccessToken(ctx, strings.TrimPrefix(msg.Token, model.PatScheme), msg.Ip)
+		if err != nil {
+			return nil, err
+		}
+		id = pat.MemberId
+		scopes = pat.ScopeList()
+	} else {
+		var claims *jwts.TokenClaims
+		claims, err = ls.verifyJwt(ctx, msg.Token)
+		if err != nil {
+			return nil, err
+		}
+		// 将解析后的token转换为用户ID
+		id, _ = strconv.ParseInt(claims.Val, 10, 64)
+		orgId = claims.Org
 	}
 
-	// 将解析后的token转换为用户ID
-	id, _ := strconv.ParseInt(parseToken, 10, 64)
-
 	// 根据用户ID从数据库中查询用户信息
 	// 注意：这里可以进行优化，例如在用户登录后缓存用户信息，以减少数据库查询
-	memberById, err := ls.memberRepo.FindMemberById(context.Background(), id)
+	memberById, err := ls.memberRepo.FindMemberById(ctx, id)
 	if err != nil {
 		// 如果数据库查询失败，记录错误日志，并返回数据库错误
-		zap.L().Error("TokenVerify db FindMemberById error", zap.Error(err))
+		logs.Ctx(ctx).Error("TokenVerify db FindMemberById error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
@@ -289,17 +357,44 @@ func (ls *LoginService) TokenVerify(ctx context.Context, msg *login.LoginMessage
 	copier.Copy(memMsg, memberById)
 	// 加密用户ID
 	memMsg.Code, _ = encrypts.EncryptInt64(memberById.Id, model.AESKey)
-	orgs, err := ls.organizationRepo.FindOrganizationByMemId(context.Background(), memberById.Id)
+	// 令牌中携带了切换后的组织时使用该组织，成员已经不在该组织中时回到默认组织
+	orgs, err := ls.memberOrgs(ctx, memberById.Id)
 	if err != nil {
-		zap.L().Error("TokenVerify db FindMember error", zap.Error(err))
+		logs.Ctx(ctx).Error("TokenVerify db FindMember error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
-	if len(orgs) > 0 {
-		memMsg.OrganizationCode, _ = encrypts.EncryptInt64(orgs[0].Id, model.AESKey)
+	if org := selectOrg(orgs, orgId); org != nil {
+		memMsg.OrganizationCode, _ = encrypts.EncryptInt64(org.Id, model.AESKey)
 	}
 	memMsg.CreateTime = tms.FormatByMill(memberById.CreateTime)
 	// 返回包含用户信息的登录响应
-	return &login.LoginResponse{Member: memMsg}, nil
+	return &login.LoginResponse{Member: memMsg, Scopes: scopes}, nil
+}
+
+// verifyJwt 校验登录令牌，返回令牌中携带的声明
+func (ls *LoginService) verifyJwt(ctx context.Context, token string) (*jwts.TokenClaims, error) {
+	// 提取token信息，并处理带有bearer前缀的token
+	if strings.Contains(token, "bearer") {
+		token = strings.ReplaceAll(token, "bearer ", "")
+	}
+
+	// 解析token，验证其有效性
+	claims, err := jwts.ParseClaimsWithKeySet(token, config.C.JwtConfig.KeySet)
+	if err != nil {
+		// 如果token验证失败，记录错误日志，并返回登录错误
+		logs.Ctx(ctx).Error("Login  TokenVerify error", zap.Error(err))
+		return nil, errs.GrpcError(model.NoLogin)
+	}
+	// 令牌被吊销（退出登录、令牌家族作废或退出所有设备）后不再有效
+	revoked, err := ls.tokenRevoked(ctx, claims)
+	if err != nil {
+		logs.Ctx(ctx).Error("TokenVerify tokenRevoked error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	if revoked {
+		return nil, errs.GrpcError(model.NoLogin)
+	}
+	return claims, nil
 }
 
 // TokenVerify 验证用户登录状态（将token存入对应队列）
@@ -313,20 +408,24 @@ func (ls *LoginService) TokenVerifyOld(ctx context.Context, msg *login.LoginMess
 	}
 
 	// 解析Token，如果解析失败，记录错误日志并返回登录错误
-	parseToken, err := jwts.ParseToken(token, config.C.JwtConfig.AccessSecret, msg.Ip)
+	claims, err := jwts.ParseClaimsWithKeySet(token, config.C.JwtConfig.KeySet)
+	if err == nil && claims.Ip != msg.Ip {
+		err = errs.GrpcError(model.NoLogin)
+	}
 	if err != nil {
-		zap.L().Error("Login  TokenVerify error", zap.Error(err))
+		logs.Ctx(ctx).Error("Login  TokenVerify error", zap.Error(err))
 		return nil, errs.GrpcError(model.NoLogin)
 	}
+	parseToken := claims.Val
 
 	// 从缓存中查询用户信息，如果查询失败或信息为空，记录错误日志并返回登录错误
-	memJson, err := ls.cache.Get(context.Background(), model.Member+"::"+parseToken)
+	memJson, err := ls.cache.Get(ctx, model.Member+"::"+parseToken)
 	if err != nil {
-		zap.L().Error("TokenVerify cache get member error", zap.Error(err))
+		logs.Ctx(ctx).Error("TokenVerify cache get member error", zap.Error(err))
 		return nil, errs.GrpcError(model.NoLogin)
 	}
 	if memJson == "" {
-		zap.L().Error("TokenVerify cache get member expire")
+		logs.Ctx(ctx).Error("TokenVerify cache get member expire")
 		return nil, errs.GrpcError(model.NoLogin)
 	}
 
@@ -338,13 +437,13 @@ func (ls *LoginService) TokenVerifyOld(ctx context.Context, msg *login.LoginMess
 	memMsg.Code, _ = encrypts.EncryptInt64(memberById.Id, model.AESKey)
 
 	// 从缓存中查询用户组织信息，如果查询失败或信息为空，记录错误日志并返回登录错误
-	orgsJson, err := ls.cache.Get(context.Background(), model.MemberOrganization+"::"+parseToken)
+	orgsJson, err := ls.cache.Get(ctx, model.MemberOrganization+"::"+parseToken)
 	if err != nil {
-		zap.L().Error("TokenVerify cache get organization error", zap.Error(err))
+		logs.Ctx(ctx).Error("TokenVerify cache get organization error", zap.Error(err))
 		return nil, errs.GrpcError(model.NoLogin)
 	}
 	if orgsJson == "" {
-		zap.L().Error("TokenVerify cache get organization expire")
+		logs.Ctx(ctx).Error("TokenVerify cache get organization expire")
 		return nil, errs.GrpcError(model.NoLogin)
 	}
 
@@ -367,11 +466,11 @@ func (l *LoginService) MyOrgList(ctx context.Context, msg *login.UserMessage) (*
 	// 提取用户ID
 	memId := msg.MemId
 
-	// 调用组织仓库的FindOrganizationByMemId方法查询用户所属的组织
-	orgs, err := l.organizationRepo.FindOrganizationByMemId(ctx, memId)
+	// 查询用户所属的组织
+	orgs, err := l.memberOrgs(ctx, memId)
 	if err != nil {
 		// 如果查询过程中出现错误，记录错误日志并返回相应的gRPC错误
-		zap.L().Error("MyOrgList FindOrganizationByMemId err", zap.Error(err))
+		logs.Ctx(ctx).Error("MyOrgList FindOrganizationByMemId err", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
@@ -397,10 +496,10 @@ func (l *LoginService) MyOrgList(ctx context.Context, msg *login.UserMessage) (*
 // 最后，将找到的信息进行处理，如加密组织代码，并格式化创建时间，然后返回会员信息。
 func (ls *LoginService) FindMemInfoById(ctx context.Context, msg *login.UserMessage) (*login.MemberMessage, error) {
 	// 通过成员仓库中的FindMemberById方法查找会员信息。
-	memberById, err := ls.memberRepo.FindMemberById(context.Background(), msg.MemId)
+	memberById, err := ls.memberRepo.FindMemberById(ctx, msg.MemId)
 	if err != nil {
 		// 如果查找过程中出现错误，记录日志并返回错误。
-		zap.L().Error("TokenVerify db FindMemberById error", zap.Error(err))
+		logs.Ctx(ctx).Error("TokenVerify db FindMemberById error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
@@ -411,17 +510,17 @@ func (ls *LoginService) FindMemInfoById(ctx context.Context, msg *login.UserMess
 	// 加密会员ID并赋值给会员信息对象。
 	memMsg.Code, _ = encrypts.EncryptInt64(memberById.Id, model.AESKey)
 
-	// 通过组织仓库中的FindOrganizationByMemId方法查找该会员所属的组织信息。
-	orgs, err := ls.organizationRepo.FindOrganizationByMemId(context.Background(), memberById.Id)
+	// 查找该会员所属的组织信息。
+	orgs, err := ls.memberOrgs(ctx, memberById.Id)
 	if err != nil {
 		// 如果查找过程中出现错误，记录日志并返回错误。
-		zap.L().Error("TokenVerify db FindMember error", zap.Error(err))
+		logs.Ctx(ctx).Error("TokenVerify db FindMember error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 
-	// 如果找到了所属组织信息，则加密组织ID并赋值给会员信息对象。
-	if len(orgs) > 0 {
-		memMsg.OrganizationCode, _ = encrypts.EncryptInt64(orgs[0].Id, model.AESKey)
+	// 调用方指定了组织并且会员属于该组织时使用该组织，否则使用默认组织，加密组织ID并赋值给会员信息对象。
+	if org := selectOrg(orgs, encrypts.DecryptNoErr(msg.OrganizationCode)); org != nil {
+		memMsg.OrganizationCode, _ = encrypts.EncryptInt64(org.Id, model.AESKey)
 	}
 
 	// 格式化会员信息的创建时间。
@@ -435,10 +534,10 @@ func (ls *LoginService) FindMemInfoById(ctx context.Context, msg *login.UserMess
 // 该方法从数据库中获取会员信息，并将结果格式化后返回。
 func (ls *LoginService) FindMemInfoByIds(ctx context.Context, msg *login.UserMessage) (*login.MemberMessageList, error) {
 	// 调用memberRepo的FindMemberByIds方法查询会员信息。
-	memberList, err := ls.memberRepo.FindMemberByIds(context.Background(), msg.MIds)
+	memberList, err := ls.memberRepo.FindMemberByIds(ctx, msg.MIds)
 	if err != nil {
 		// 如果查询过程中出现错误，记录错误日志并返回DBError错误。
-		zap.L().Error("FindMemInfoByIds db memberRepo.FindMemberByIds error", zap.Error(err))
+		logs.Ctx(ctx).Error("FindMemInfoByIds db memberRepo.FindMemberByIds error", zap.Error(err))
 		return nil, errs.GrpcError(model.DBError)
 	}
 	// 如果查询结果为空，返回一个空的MemberMessageList对象。
@@ -0,0 +1,60 @@
+package login_service_v1
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"project-common/errs"
+	"project-common/jwts"
+	"project-common/logs"
+	"project-grpc/user/login"
+	"project-user/config"
+	"project-user/pkg/model"
+	"strings"
+)
+
+// Logout 退出当前登录。
+// 访问令牌的 jti 会被加入吊销列表，当前登录的令牌家族被作废，对应的刷新令牌也随之失效。
+func (ls *LoginService) Logout(ctx context.Context, msg *login.LogoutMessage) (*login.LogoutResponse, error) {
+	c := ctx
+	claims, err := ls.parseLogoutToken(c, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err = ls.revokeFamily(c, claims); err != nil {
+		logs.Ctx(ctx).Error("Logout revokeFamily error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	return &login.LogoutResponse{}, nil
+}
+
+// LogoutAll 退出所有设备上的登录。
+// 用户的令牌版本递增后，之前签发的所有访问令牌和刷新令牌都不再有效。
+func (ls *LoginService) LogoutAll(ctx context.Context, msg *login.LogoutMessage) (*login.LogoutResponse, error) {
+	c := ctx
+	claims, err := ls.parseLogoutToken(c, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err = ls.revokeToken(c, claims); err != nil {
+		logs.Ctx(ctx).Error("LogoutAll revokeToken error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	if err = ls.revokeAllTokens(c, claims.Val); err != nil {
+		logs.Ctx(ctx).Error("LogoutAll cache incr token version error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	return &login.LogoutResponse{}, nil
+}
+
+// parseLogoutToken 解析退出登录时携带的访问令牌
+func (ls *LoginService) parseLogoutToken(ctx context.Context, token string) (*jwts.TokenClaims, error) {
+	if strings.Contains(token, "bearer") {
+		token = strings.ReplaceAll(token, "bearer ", "")
+	}
+	claims, err := jwts.ParseClaimsWithKeySet(token, config.C.JwtConfig.KeySet)
+	if err != nil {
+		logs.Ctx(ctx).Error("Logout ParseClaims error", zap.Error(err))
+		return nil, errs.GrpcError(model.NoLogin)
+	}
+	return claims, nil
+}
@@ -0,0 +1,260 @@
+package login_service_v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"project-common/encrypts"
+	"project-common/errs"
+	"project-common/logs"
+	"project-common/totp"
+	"project-grpc/user/login"
+	"project-user/internal/data/member"
+	"project-user/pkg/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// mfaTicketExpire 两步验证登录票据的有效期
+	mfaTicketExpire = 5 * time.Minute
+	// mfaTicketAttempts 每个登录票据可以尝试提交动态码的次数
+	mfaTicketAttempts = 5
+	// recoveryCodeCount 开启两步验证时生成的恢复码数量
+	recoveryCodeCount = 10
+)
+
+// MfaEnroll 开始开启两步验证。
+// 生成新的TOTP密钥并以待确认状态保存，返回密钥和 otpauth:// 地址，前端渲染成二维码供身份验证器App扫描。
+func (ls *LoginService) MfaEnroll(ctx context.Context, msg *login.MfaMessage) (*login.MfaEnrollResponse, error) {
+	c := ctx
+	mem, err := ls.memberRepo.FindMemberById(c, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("MfaEnroll db FindMemberById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	mfa, err := ls.memberMfaRepo.FindMfaByMemId(c, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("MfaEnroll db FindMfaByMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if mfa != nil && mfa.Enabled == model.MfaEnabled {
+		return nil, errs.GrpcError(model.MfaAlreadyEnabled)
+	}
+	if mfa == nil {
+		mfa = &member.MemberMfa{MemberId: msg.MemId}
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		logs.Ctx(ctx).Error("MfaEnroll GenerateSecret error", zap.Error(err))
+		return nil, errs.GrpcError(model.MfaError)
+	}
+	mfa.Secret, err = encrypts.Encrypt(secret, model.AESKey)
+	if err != nil {
+		logs.Ctx(ctx).Error("MfaEnroll Encrypt error", zap.Error(err))
+		return nil, errs.GrpcError(model.MfaError)
+	}
+	mfa.Enabled = model.MfaPending
+	mfa.RecoveryCodes = ""
+	mfa.CreateTime = time.Now().UnixMilli()
+	if err = ls.memberMfaRepo.SaveMfa(c, mfa); err != nil {
+		logs.Ctx(ctx).Error("MfaEnroll db SaveMfa error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	return &login.MfaEnrollResponse{
+		Secret: secret,
+		Uri:    totp.URI(model.MfaIssuer, mem.Account, secret),
+	}, nil
+}
+
+// MfaConfirm 提交身份验证器App上的动态码，确认开启两步验证。
+// 确认成功后返回一组一次性恢复码，只展示这一次，手机丢失时可以用恢复码代替动态码。
+func (ls *LoginService) MfaConfirm(ctx context.Context, msg *login.MfaMessage) (*login.MfaConfirmResponse, error) {
+	c := ctx
+	mfa, err := ls.memberMfaRepo.FindMfaByMemId(c, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("MfaConfirm db FindMfaByMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if mfa == nil {
+		return nil, errs.GrpcError(model.MfaNotEnabled)
+	}
+	if mfa.Enabled == model.MfaEnabled {
+		return nil, errs.GrpcError(model.MfaAlreadyEnabled)
+	}
+	ok, err := ls.verifyTotp(c, mfa, msg.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errs.GrpcError(model.MfaCodeError)
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		logs.Ctx(ctx).Error("MfaConfirm generateRecoveryCodes error", zap.Error(err))
+		return nil, errs.GrpcError(model.MfaError)
+	}
+	mfa.Enabled = model.MfaEnabled
+	mfa.RecoveryCodes = strings.Join(hashes, ",")
+	mfa.EnableTime = time.Now().UnixMilli()
+	if err = ls.memberMfaRepo.SaveMfa(c, mfa); err != nil {
+		logs.Ctx(ctx).Error("MfaConfirm db SaveMfa error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	return &login.MfaConfirmResponse{RecoveryCodes: codes}, nil
+}
+
+// MfaDisable 关闭两步验证，需要提交动态码或者恢复码
+func (ls *LoginService) MfaDisable(ctx context.Context, msg *login.MfaMessage) (*login.MfaDisableResponse, error) {
+	c := ctx
+	mfa, err := ls.memberMfaRepo.FindMfaByMemId(c, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("MfaDisable db FindMfaByMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if mfa == nil || mfa.Enabled != model.MfaEnabled {
+		return nil, errs.GrpcError(model.MfaNotEnabled)
+	}
+	if err = ls.verifyMfaCode(c, mfa, msg.Code); err != nil {
+		return nil, err
+	}
+	if err = ls.memberMfaRepo.DeleteMfa(c, msg.MemId); err != nil {
+		logs.Ctx(ctx).Error("MfaDisable db DeleteMfa error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	return &login.MfaDisableResponse{}, nil
+}
+
+// LoginMfa 使用登录票据和动态码（或恢复码）完成两步验证登录
+func (ls *LoginService) LoginMfa(ctx context.Context, msg *login.LoginMfaMessage) (*login.LoginResponse, error) {
+	c := ctx
+	// 1. 校验票据
+	memIdStr, err := ls.cache.Get(c, model.MfaTicket+"::"+msg.Ticket)
+	if err == redis.Nil {
+		return nil, errs.GrpcError(model.MfaTicketError)
+	}
+	if err != nil {
+		logs.Ctx(ctx).Error("LoginMfa cache get ticket error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	// 2. 每个票据只能尝试有限次数，超过后票据作废
+	attemptsKey := model.MfaTicketAttempts + "::" + msg.Ticket
+	over, err := ls.overLimit(c, attemptsKey, mfaTicketAttempts, mfaTicketExpire)
+	if err != nil {
+		logs.Ctx(ctx).Error("LoginMfa cache incr attempts error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	if over {
+		ls.cache.Del(c, model.MfaTicket+"::"+msg.Ticket, attemptsKey)
+		return nil, errs.GrpcError(model.MfaTicketError)
+	}
+	// 3. 校验动态码
+	memId, _ := strconv.ParseInt(memIdStr, 10, 64)
+	lg := &member.LoginLog{MemberId: memId, Ip: msg.Ip, UserAgent: msg.UserAgent, Method: model.LoginMethodMfa}
+	mfa, err := ls.memberMfaRepo.FindMfaByMemId(c, memId)
+	if err != nil {
+		logs.Ctx(ctx).Error("LoginMfa db FindMfaByMemId error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if mfa == nil || mfa.Enabled != model.MfaEnabled {
+		return nil, errs.GrpcError(model.MfaTicketError)
+	}
+	if err = ls.verifyMfaCode(c, mfa, msg.Code); err != nil {
+		return nil, ls.saveLoginLog(c, lg, err)
+	}
+	// 4. 票据只能使用一次
+	if _, err = ls.cache.Del(c, model.MfaTicket+"::"+msg.Ticket, attemptsKey); err != nil {
+		logs.Ctx(ctx).Error("LoginMfa cache del ticket error", zap.Error(err))
+	}
+	mem, err := ls.memberRepo.FindMemberById(c, memId)
+	if err != nil {
+		logs.Ctx(ctx).Error("LoginMfa db FindMemberById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	lg.Account = mem.Account
+	return ls.loginSuccess(c, mem, lg)
+}
+
+// createMfaTicket 创建两步验证登录票据
+func (ls *LoginService) createMfaTicket(ctx context.Context, memId int64) (string, error) {
+	ticket := uuid.NewString()
+	err := ls.cache.Put(ctx, model.MfaTicket+"::"+ticket, strconv.FormatInt(memId, 10), mfaTicketExpire)
+	return ticket, err
+}
+
+// verifyMfaCode 校验动态码或者恢复码，恢复码使用后立即作废
+func (ls *LoginService) verifyMfaCode(ctx context.Context, mfa *member.MemberMfa, code string) error {
+	code = strings.TrimSpace(code)
+	ok, err := ls.verifyTotp(ctx, mfa, code)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	// 不是有效的动态码时，按恢复码校验
+	hash := encrypts.Sha256(strings.ToLower(code))
+	hashes := strings.Split(mfa.RecoveryCodes, ",")
+	for i, h := range hashes {
+		if h == "" || h != hash {
+			continue
+		}
+		mfa.RecoveryCodes = strings.Join(append(hashes[:i:i], hashes[i+1:]...), ",")
+		if err = ls.memberMfaRepo.SaveMfa(ctx, mfa); err != nil {
+			logs.Ctx(ctx).Error("verifyMfaCode db SaveMfa error", zap.Error(err))
+			return errs.GrpcError(model.DBError)
+		}
+		return nil
+	}
+	return errs.GrpcError(model.MfaCodeError)
+}
+
+// verifyTotp 校验TOTP动态码，同一个时间步的动态码只能使用一次，防止被截获后重放
+func (ls *LoginService) verifyTotp(ctx context.Context, mfa *member.MemberMfa, code string) (bool, error) {
+	secret, err := encrypts.Decrypt(mfa.Secret, model.AESKey)
+	if err != nil {
+		logs.Ctx(ctx).Error("verifyTotp Decrypt error", zap.Error(err))
+		return false, errs.GrpcError(model.MfaError)
+	}
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	usedKey := model.MfaUsedStep + "::" + strconv.FormatInt(mfa.MemberId, 10)
+	last, err := ls.cache.Get(ctx, usedKey)
+	if err != nil && err != redis.Nil {
+		logs.Ctx(ctx).Error("verifyTotp cache get used step error", zap.Error(err))
+		return false, errs.GrpcError(model.RedisError)
+	}
+	if lastStep, _ := strconv.ParseUint(last, 10, 64); lastStep >= step {
+		return false, nil
+	}
+	expire := time.Duration(totp.Period*(2*totp.Skew+1)) * time.Second
+	if err = ls.cache.Put(ctx, usedKey, strconv.FormatUint(step, 10), expire); err != nil {
+		logs.Ctx(ctx).Error("verifyTotp cache put used step error", zap.Error(err))
+		return false, errs.GrpcError(model.RedisError)
+	}
+	return true, nil
+}
+
+// generateRecoveryCodes 生成恢复码，返回明文（展示给用户）和哈希（保存到数据库）
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		h := hex.EncodeToString(b)
+		code := fmt.Sprintf("%s-%s", h[:5], h[5:])
+		codes = append(codes, code)
+		hashes = append(hashes, encrypts.Sha256(code))
+	}
+	return codes, hashes, nil
+}
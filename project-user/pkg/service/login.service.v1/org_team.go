@@ -0,0 +1,338 @@
+package login_service_v1
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"project-common/encrypts"
+	"project-common/errs"
+	"project-common/logs"
+	"project-grpc/user/login"
+	"project-user/internal/data/member"
+	"project-user/internal/data/organization"
+	"project-user/internal/database"
+	"project-user/pkg/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// orgInvite 缓存中保存的组织邀请
+type orgInvite struct {
+	OrgId     int64 `json:"orgId"`
+	InviterId int64 `json:"inviterId"`
+}
+
+// CreateOrganization 创建团队组织。
+// 创建者成为组织的拥有者，同时初始化组织的管理员和成员两个默认角色。
+func (ls *LoginService) CreateOrganization(ctx context.Context, msg *login.CreateOrganizationMessage) (*login.OrganizationMessage, error) {
+	c := ctx
+	name := strings.TrimSpace(msg.Name)
+	if name == "" {
+		return nil, errs.GrpcError(model.OrgNameEmpty)
+	}
+	mem, err := ls.memberRepo.FindMemberById(c, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("CreateOrganization db FindMemberById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	now := time.Now().UnixMilli()
+	org := &organization.Organization{
+		Name:        name,
+		Avatar:      msg.Avatar,
+		Description: msg.Description,
+		Address:     msg.Address,
+		MemberId:    mem.Id,
+		CreateTime:  now,
+	}
+	err = ls.transaction.Action(func(conn database.DbConn) error {
+		if err := ls.organizationRepo.SaveOrganization(conn, c, org); err != nil {
+			logs.Ctx(ctx).Error("CreateOrganization db SaveOrganization error", zap.Error(err))
+			return errs.GrpcError(model.DBError)
+		}
+		admin := &organization.ProjectAuth{
+			OrganizationCode: org.Id,
+			Title:            "管理员",
+			CreateAt:         now,
+			Status:           1,
+			CreateBy:         mem.Id,
+			Type:             model.AuthTypeAdmin,
+		}
+		memberAuth := &organization.ProjectAuth{
+			OrganizationCode: org.Id,
+			Title:            "成员",
+			CreateAt:         now,
+			Sort:             1,
+			Status:           1,
+			CreateBy:         mem.Id,
+			IsDefault:        1,
+			Type:             model.AuthTypeMember,
+		}
+		for _, auth := range []*organization.ProjectAuth{admin, memberAuth} {
+			if err := ls.projectAuthRepo.SaveProjectAuth(conn, c, auth); err != nil {
+				logs.Ctx(ctx).Error("CreateOrganization db SaveProjectAuth error", zap.Error(err))
+				return errs.GrpcError(model.DBError)
+			}
+		}
+		ma := newMemberAccount(mem, org.Id, admin.Id)
+		ma.IsOwner = 1
+		if err := ls.memberAccountRepo.SaveMemberAccount(conn, c, ma); err != nil {
+			logs.Ctx(ctx).Error("CreateOrganization db SaveMemberAccount error", zap.Error(err))
+			return errs.GrpcError(model.DBError)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ls.invalidateMember(c, strconv.FormatInt(mem.Id, 10))
+	return toOrgMessage(org), nil
+}
+
+// CreateOrgInvite 生成组织邀请码，邀请码在有效期内可以被多人使用。
+// 只有组织的拥有者和管理员可以邀请成员，个人组织不能邀请成员。
+func (ls *LoginService) CreateOrgInvite(ctx context.Context, msg *login.OrgInviteMessage) (*login.OrgInviteResponse, error) {
+	c := ctx
+	org, err := ls.adminOrg(c, msg.OrganizationCode, msg.MemId)
+	if err != nil {
+		return nil, err
+	}
+	hours := msg.ExpireHours
+	if hours <= 0 {
+		hours = model.InviteExpireHours
+	}
+	if hours > model.MaxInviteExpireHours {
+		hours = model.MaxInviteExpireHours
+	}
+	code := strings.ReplaceAll(uuid.NewString(), "-", "")
+	invite, _ := json.Marshal(&orgInvite{OrgId: org.Id, InviterId: msg.MemId})
+	expire := time.Duration(hours) * time.Hour
+	if err = ls.cache.Put(c, model.OrgInvite+"::"+code, string(invite), expire); err != nil {
+		logs.Ctx(ctx).Error("CreateOrgInvite cache put error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	return &login.OrgInviteResponse{
+		InviteCode: code,
+		ExpireTime: time.Now().Add(expire).UnixMilli(),
+	}, nil
+}
+
+// AcceptOrgInvite 使用邀请码加入组织，以组织的默认成员角色创建 member_account 记录
+func (ls *LoginService) AcceptOrgInvite(ctx context.Context, msg *login.OrgInviteMessage) (*login.OrganizationMessage, error) {
+	c := ctx
+	value, err := ls.cache.Get(c, model.OrgInvite+"::"+msg.InviteCode)
+	if err == redis.Nil {
+		return nil, errs.GrpcError(model.InviteError)
+	}
+	if err != nil {
+		logs.Ctx(ctx).Error("AcceptOrgInvite cache get error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	invite := &orgInvite{}
+	if err = json.Unmarshal([]byte(value), invite); err != nil {
+		return nil, errs.GrpcError(model.InviteError)
+	}
+	org, err := ls.organizationRepo.FindOrganizationById(c, invite.OrgId)
+	if err != nil {
+		logs.Ctx(ctx).Error("AcceptOrgInvite db FindOrganizationById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if org == nil {
+		return nil, errs.GrpcError(model.InviteError)
+	}
+	if org.MemberId == msg.MemId {
+		return nil, errs.GrpcError(model.AlreadyOrgMember)
+	}
+	exist, err := ls.memberAccountRepo.FindMemberAccount(c, org.Id, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("AcceptOrgInvite db FindMemberAccount error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if exist != nil {
+		return nil, errs.GrpcError(model.AlreadyOrgMember)
+	}
+	mem, err := ls.memberRepo.FindMemberById(c, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("AcceptOrgInvite db FindMemberById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	auth, err := ls.projectAuthRepo.FindAuthByType(c, org.Id, model.AuthTypeMember)
+	if err != nil {
+		logs.Ctx(ctx).Error("AcceptOrgInvite db FindAuthByType error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	var authId int64
+	if auth != nil {
+		authId = auth.Id
+	}
+	err = ls.transaction.Action(func(conn database.DbConn) error {
+		if err := ls.memberAccountRepo.SaveMemberAccount(conn, c, newMemberAccount(mem, org.Id, authId)); err != nil {
+			logs.Ctx(ctx).Error("AcceptOrgInvite db SaveMemberAccount error", zap.Error(err))
+			return errs.GrpcError(model.DBError)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ls.invalidateMember(c, strconv.FormatInt(mem.Id, 10))
+	return toOrgMessage(org), nil
+}
+
+// RemoveOrgMember 将成员移出组织。
+// 组织的拥有者和管理员可以移除其他成员，成员也可以自己退出组织，拥有者需要先转让组织才能退出。
+func (ls *LoginService) RemoveOrgMember(ctx context.Context, msg *login.OrgMemberMessage) (*login.OrgMemberResponse, error) {
+	c := ctx
+	orgId := encrypts.DecryptNoErr(msg.OrganizationCode)
+	memberId := encrypts.DecryptNoErr(msg.MemberCode)
+	org, err := ls.organizationRepo.FindOrganizationById(c, orgId)
+	if err != nil {
+		logs.Ctx(ctx).Error("RemoveOrgMember db FindOrganizationById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if org == nil {
+		return nil, errs.GrpcError(model.NotOrgMember)
+	}
+	if memberId != msg.MemId {
+		isAdmin, err := ls.isOrgAdmin(c, orgId, msg.MemId)
+		if err != nil {
+			logs.Ctx(ctx).Error("RemoveOrgMember isOrgAdmin error", zap.Error(err))
+			return nil, errs.GrpcError(model.DBError)
+		}
+		if !isAdmin {
+			return nil, errs.GrpcError(model.NoPermission)
+		}
+	}
+	if memberId == org.MemberId {
+		return nil, errs.GrpcError(model.OrgOwnerDeny)
+	}
+	ma, err := ls.memberAccountRepo.FindMemberAccount(c, orgId, memberId)
+	if err != nil {
+		logs.Ctx(ctx).Error("RemoveOrgMember db FindMemberAccount error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if ma == nil {
+		return nil, errs.GrpcError(model.NotOrgMember)
+	}
+	if err = ls.memberAccountRepo.DeleteMemberAccount(c, orgId, memberId); err != nil {
+		logs.Ctx(ctx).Error("RemoveOrgMember db DeleteMemberAccount error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	ls.invalidateMember(c, strconv.FormatInt(memberId, 10))
+	return &login.OrgMemberResponse{}, nil
+}
+
+// TransferOrganization 将组织转让给组织中的另一个成员，只有当前的拥有者可以转让。
+// 新拥有者获得管理员角色，原拥有者降为普通成员。
+func (ls *LoginService) TransferOrganization(ctx context.Context, msg *login.OrgMemberMessage) (*login.OrgMemberResponse, error) {
+	c := ctx
+	org, err := ls.adminOrg(c, msg.OrganizationCode, msg.MemId)
+	if err != nil {
+		return nil, err
+	}
+	if org.MemberId != msg.MemId {
+		return nil, errs.GrpcError(model.NoPermission)
+	}
+	memberId := encrypts.DecryptNoErr(msg.MemberCode)
+	target, err := ls.memberAccountRepo.FindMemberAccount(c, org.Id, memberId)
+	if err != nil {
+		logs.Ctx(ctx).Error("TransferOrganization db FindMemberAccount error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if target == nil || memberId == msg.MemId {
+		return nil, errs.GrpcError(model.NotOrgMember)
+	}
+	owner, err := ls.memberAccountRepo.FindMemberAccount(c, org.Id, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("TransferOrganization db FindMemberAccount error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	adminAuth, err := ls.projectAuthRepo.FindAuthByType(c, org.Id, model.AuthTypeAdmin)
+	if err != nil {
+		logs.Ctx(ctx).Error("TransferOrganization db FindAuthByType error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	memberAuth, err := ls.projectAuthRepo.FindAuthByType(c, org.Id, model.AuthTypeMember)
+	if err != nil {
+		logs.Ctx(ctx).Error("TransferOrganization db FindAuthByType error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	err = ls.transaction.Action(func(conn database.DbConn) error {
+		if err := ls.organizationRepo.UpdateOwner(conn, c, org.Id, memberId); err != nil {
+			logs.Ctx(ctx).Error("TransferOrganization db UpdateOwner error", zap.Error(err))
+			return errs.GrpcError(model.DBError)
+		}
+		if err := ls.memberAccountRepo.UpdateOwner(conn, c, target.Id, 1, authIdStr(adminAuth, target.Authorize)); err != nil {
+			logs.Ctx(ctx).Error("TransferOrganization db UpdateOwner error", zap.Error(err))
+			return errs.GrpcError(model.DBError)
+		}
+		if owner != nil {
+			if err := ls.memberAccountRepo.UpdateOwner(conn, c, owner.Id, 0, authIdStr(memberAuth, owner.Authorize)); err != nil {
+				logs.Ctx(ctx).Error("TransferOrganization db UpdateOwner error", zap.Error(err))
+				return errs.GrpcError(model.DBError)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ls.invalidateMember(c, strconv.FormatInt(msg.MemId, 10))
+	ls.invalidateMember(c, strconv.FormatInt(memberId, 10))
+	return &login.OrgMemberResponse{}, nil
+}
+
+// adminOrg 查询团队组织并校验操作人是组织的拥有者或管理员
+func (ls *LoginService) adminOrg(ctx context.Context, orgCode string, memId int64) (*organization.Organization, error) {
+	orgId := encrypts.DecryptNoErr(orgCode)
+	org, err := ls.organizationRepo.FindOrganizationById(ctx, orgId)
+	if err != nil {
+		logs.Ctx(ctx).Error("adminOrg db FindOrganizationById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if org == nil {
+		return nil, errs.GrpcError(model.NotOrgMember)
+	}
+	if org.Personal == model.Personal {
+		return nil, errs.GrpcError(model.PersonalOrgDeny)
+	}
+	isAdmin, err := ls.isOrgAdmin(ctx, orgId, memId)
+	if err != nil {
+		logs.Ctx(ctx).Error("adminOrg isOrgAdmin error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if !isAdmin {
+		return nil, errs.GrpcError(model.NoPermission)
+	}
+	return org, nil
+}
+
+// newMemberAccount 根据成员信息生成组织中的成员账号
+func newMemberAccount(mem *member.Member, orgId int64, authId int64) *member.MemberAccount {
+	ma := &member.MemberAccount{
+		OrganizationCode: orgId,
+		MemberCode:       mem.Id,
+		Name:             mem.Name,
+		Mobile:           mem.Mobile,
+		Email:            mem.Email,
+		Avatar:           mem.Avatar,
+		Description:      mem.Description,
+		CreateTime:       time.Now().UnixMilli(),
+		LastLoginTime:    mem.LastLoginTime,
+		Status:           model.Normal,
+	}
+	if authId > 0 {
+		ma.Authorize = strconv.FormatInt(authId, 10)
+	}
+	return ma
+}
+
+// authIdStr 返回角色id，角色不存在时保留原来的角色
+func authIdStr(auth *organization.ProjectAuth, old string) string {
+	if auth == nil {
+		return old
+	}
+	return strconv.FormatInt(auth.Id, 10)
+}
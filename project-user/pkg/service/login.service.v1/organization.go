@@ -0,0 +1,118 @@
+package login_service_v1
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+	"go.uber.org/zap"
+	"project-common/encrypts"
+	"project-common/errs"
+	"project-common/logs"
+	"project-common/tms"
+	"project-grpc/user/login"
+	"project-user/internal/data/member"
+	"project-user/internal/data/organization"
+	"project-user/pkg/model"
+	"strconv"
+)
+
+// SwitchOrganization 切换当前组织。
+// 校验成员属于目标组织后，作废当前的令牌家族，签发携带新组织的令牌对，
+// 之后 TokenVerify 返回的 organizationCode 就是切换后的组织。
+func (ls *LoginService) SwitchOrganization(ctx context.Context, msg *login.SwitchOrganizationMessage) (*login.LoginResponse, error) {
+	c := ctx
+	claims, err := ls.verifyJwt(c, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+	memId, _ := strconv.ParseInt(claims.Val, 10, 64)
+	orgs, err := ls.memberOrgs(c, memId)
+	if err != nil {
+		logs.Ctx(ctx).Error("SwitchOrganization memberOrgs error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	orgId := encrypts.DecryptNoErr(msg.OrganizationCode)
+	org := selectOrg(orgs, orgId)
+	if org == nil || org.Id != orgId {
+		return nil, errs.GrpcError(model.NotOrgMember)
+	}
+	mem, err := ls.memberRepo.FindMemberById(c, memId)
+	if err != nil {
+		logs.Ctx(ctx).Error("SwitchOrganization db FindMemberById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	// 旧令牌携带的是之前的组织，整个家族作废，避免用旧的刷新令牌换回之前的组织
+	if err = ls.revokeFamily(c, claims); err != nil {
+		logs.Ctx(ctx).Error("SwitchOrganization revokeFamily error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	// 切换组织开启一个新的会话，写入登录记录后会出现在活跃会话列表中
+	lg := &member.LoginLog{
+		MemberId:  memId,
+		Account:   mem.Account,
+		Ip:        msg.Ip,
+		UserAgent: msg.UserAgent,
+		Method:    model.LoginMethodSwitch,
+		Family:    uuid.NewString(),
+	}
+	tokenList, err := ls.createToken(c, strconv.FormatInt(memId, 10), msg.Ip, lg.Family, orgId)
+	if err != nil {
+		logs.Ctx(ctx).Error("SwitchOrganization createToken error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	ls.saveLoginLog(c, lg, nil)
+	memMsg := &login.MemberMessage{}
+	copier.Copy(memMsg, mem)
+	memMsg.Code, _ = encrypts.EncryptInt64(mem.Id, model.AESKey)
+	memMsg.OrganizationCode, _ = encrypts.EncryptInt64(orgId, model.AESKey)
+	memMsg.LastLoginTime = tms.FormatByMill(mem.LastLoginTime)
+	memMsg.CreateTime = tms.FormatByMill(mem.CreateTime)
+	return &login.LoginResponse{Member: memMsg, TokenList: tokenList}, nil
+}
+
+// memberOrgs 查询成员所属的全部组织：先是自己拥有的组织（第一个为默认组织），再是通过邀请加入的组织
+func (ls *LoginService) memberOrgs(ctx context.Context, memId int64) ([]*organization.Organization, error) {
+	orgs, err := ls.organizationRepo.FindOrganizationByMemId(ctx, memId)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := ls.memberAccountRepo.FindMemberAccountsByMemId(ctx, memId)
+	if err != nil {
+		return nil, err
+	}
+	owned := organization.ToMap(orgs)
+	var ids []int64
+	for _, v := range accounts {
+		if _, ok := owned[v.OrganizationCode]; !ok {
+			ids = append(ids, v.OrganizationCode)
+		}
+	}
+	joined, err := ls.organizationRepo.FindOrganizationByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return append(orgs, joined...), nil
+}
+
+// toOrgMessage 将组织转换为响应消息
+func toOrgMessage(org *organization.Organization) *login.OrganizationMessage {
+	msg := &login.OrganizationMessage{}
+	copier.Copy(msg, org)
+	msg.Code = encrypts.EncryptNoErr(org.Id)
+	msg.OwnerCode = encrypts.EncryptNoErr(org.MemberId)
+	msg.CreateTime = tms.FormatByMill(org.CreateTime)
+	return msg
+}
+
+// selectOrg 从成员所属的组织中选出 orgId 对应的组织，orgId 为0或者成员不属于该组织时返回默认组织
+func selectOrg(orgs []*organization.Organization, orgId int64) *organization.Organization {
+	if len(orgs) == 0 {
+		return nil
+	}
+	for _, v := range orgs {
+		if v.Id == orgId {
+			return v
+		}
+	}
+	return orgs[0]
+}
@@ -0,0 +1,199 @@
+package login_service_v1
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jinzhu/copier"
+	"go.uber.org/zap"
+	common "project-common"
+	"project-common/encrypts"
+	"project-common/errs"
+	"project-common/logs"
+	"project-common/tms"
+	"project-grpc/user/login"
+	"project-user/config"
+	"project-user/internal/data/member"
+	"project-user/pkg/model"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// UpdateProfile 修改个人资料，不包括密码、手机号和邮箱
+func (ls *LoginService) UpdateProfile(ctx context.Context, msg *login.ProfileMessage) (*login.MemberMessage, error) {
+	c := ctx
+	name := strings.TrimSpace(msg.Name)
+	if name == "" || utf8.RuneCountInString(name) > 50 || msg.Sex < 0 || msg.Sex > 2 {
+		return nil, errs.GrpcError(model.ProfileParamError)
+	}
+	values := map[string]any{
+		"name":        name,
+		"realname":    strings.TrimSpace(msg.Realname),
+		"sex":         msg.Sex,
+		"avatar":      msg.Avatar,
+		"province":    msg.Province,
+		"city":        msg.City,
+		"area":        msg.Area,
+		"address":     msg.Address,
+		"description": msg.Description,
+		"idcard":      msg.Idcard,
+	}
+	return ls.updateMember(c, msg.MemId, values, map[string]any{"name": name, "avatar": msg.Avatar})
+}
+
+// ChangePassword 校验原密码后修改密码。
+// 原密码校验失败和登录失败共用计数，连续输错同样会锁定账号和退避ip。
+// 修改成功后用户之前签发的所有令牌全部失效，需要使用新密码重新登录。
+func (ls *LoginService) ChangePassword(ctx context.Context, msg *login.ChangePasswordMessage) (*login.ChangePasswordResponse, error) {
+	c := ctx
+	if msg.Password == "" {
+		return nil, errs.GrpcError(model.ProfileParamError)
+	}
+	mem, err := ls.memberRepo.FindMemberById(c, msg.MemId)
+	if err != nil {
+		logs.Ctx(ctx).Error("ChangePassword db FindMemberById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if mem == nil {
+		return nil, errs.GrpcError(model.MemberNotExist)
+	}
+	if err = ls.checkLoginAllowed(c, mem.Account, msg.Ip); err != nil {
+		return nil, err
+	}
+	if ok, _ := encrypts.VerifyPassword(mem.Password, msg.OldPassword); !ok {
+		locked, err := ls.recordFailure(c, mem.Account, mem.Id, msg.Ip)
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			return nil, errs.GrpcError(model.AccountLocked)
+		}
+		return nil, errs.GrpcError(model.OldPasswordError)
+	}
+	ls.clearLoginFailures(c, mem.Account, msg.Ip)
+	pwd, err := encrypts.HashPassword(msg.Password)
+	if err != nil {
+		logs.Ctx(ctx).Error("ChangePassword HashPassword error", zap.Error(err))
+		return nil, errs.GrpcError(model.PasswordHashError)
+	}
+	if err = ls.memberRepo.UpdatePassword(c, mem.Id, pwd); err != nil {
+		logs.Ctx(ctx).Error("ChangePassword db UpdatePassword error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if err = ls.revokeAllTokens(c, strconv.FormatInt(mem.Id, 10)); err != nil {
+		logs.Ctx(ctx).Error("ChangePassword revokeAllTokens error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	mem.Password = pwd
+	ls.cacheMember(c, mem)
+	return &login.ChangePasswordResponse{}, nil
+}
+
+// SendChangeCode 向新的手机号或邮箱发送验证码，新的手机号或邮箱不能已经被其他用户使用
+func (ls *LoginService) SendChangeCode(ctx context.Context, msg *login.ChangeContactMessage) (*login.CaptchaResponse, error) {
+	c := ctx
+	if err := ls.checkContactFree(c, msg.Target); err != nil {
+		return nil, err
+	}
+	code, err := ls.sendCaptcha(c, changeKeyPrefix(msg.MemId), msg.Target, msg.Ip)
+	if err != nil {
+		return nil, err
+	}
+	if !config.C.CaptchaConfig.Dev {
+		code = ""
+	}
+	return &login.CaptchaResponse{Code: code}, nil
+}
+
+// ChangeMobile 使用发送到新手机号的验证码修改手机号
+func (ls *LoginService) ChangeMobile(ctx context.Context, msg *login.ChangeContactMessage) (*login.MemberMessage, error) {
+	if !common.VerifyMobile(msg.Target) {
+		return nil, errs.GrpcError(model.NoLegalAccount)
+	}
+	return ls.changeContact(ctx, msg, "mobile")
+}
+
+// ChangeEmail 使用发送到新邮箱的验证码修改邮箱
+func (ls *LoginService) ChangeEmail(ctx context.Context, msg *login.ChangeContactMessage) (*login.MemberMessage, error) {
+	if !common.VerifyEmailFormat(msg.Target) {
+		return nil, errs.GrpcError(model.NoLegalAccount)
+	}
+	return ls.changeContact(ctx, msg, "email")
+}
+
+// changeContact 校验验证码后修改手机号或邮箱，column 为对应的数据库列名
+func (ls *LoginService) changeContact(ctx context.Context, msg *login.ChangeContactMessage, column string) (*login.MemberMessage, error) {
+	c := ctx
+	if err := ls.checkContactFree(c, msg.Target); err != nil {
+		return nil, err
+	}
+	if err := ls.verifyCaptcha(c, changeKeyPrefix(msg.MemId), msg.Target, msg.Captcha); err != nil {
+		return nil, err
+	}
+	values := map[string]any{column: msg.Target}
+	return ls.updateMember(c, msg.MemId, values, values)
+}
+
+// checkContactFree 校验手机号或邮箱格式合法，并且没有被其他用户使用
+func (ls *LoginService) checkContactFree(ctx context.Context, target string) error {
+	var exist bool
+	var err error
+	switch {
+	case common.VerifyMobile(target):
+		exist, err = ls.memberRepo.GetMemberByMobile(ctx, target)
+	case common.VerifyEmailFormat(target):
+		exist, err = ls.memberRepo.GetMemberByEmail(ctx, target)
+	default:
+		return errs.GrpcError(model.NoLegalAccount)
+	}
+	if err != nil {
+		logs.Ctx(ctx).Error("checkContactFree db error", zap.Error(err))
+		return errs.GrpcError(model.DBError)
+	}
+	if exist {
+		if strings.Contains(target, "@") {
+			return errs.GrpcError(model.EmailExist)
+		}
+		return errs.GrpcError(model.MobileExist)
+	}
+	return nil
+}
+
+// updateMember 更新用户信息，同步组织账号中的冗余字段并刷新缓存，返回更新后的用户信息
+func (ls *LoginService) updateMember(ctx context.Context, memId int64, values map[string]any, accountValues map[string]any) (*login.MemberMessage, error) {
+	if err := ls.memberRepo.UpdateMember(ctx, memId, values); err != nil {
+		logs.Ctx(ctx).Error("updateMember db UpdateMember error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	if err := ls.memberAccountRepo.UpdateByMemId(ctx, memId, accountValues); err != nil {
+		logs.Ctx(ctx).Error("updateMember db UpdateByMemId error", zap.Error(err))
+	}
+	mem, err := ls.memberRepo.FindMemberById(ctx, memId)
+	if err != nil {
+		logs.Ctx(ctx).Error("updateMember db FindMemberById error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	ls.cacheMember(ctx, mem)
+	ls.invalidateMember(ctx, strconv.FormatInt(mem.Id, 10))
+	memMsg := &login.MemberMessage{}
+	copier.Copy(memMsg, mem)
+	memMsg.Code = encrypts.EncryptNoErr(mem.Id)
+	memMsg.CreateTime = tms.FormatByMill(mem.CreateTime)
+	memMsg.LastLoginTime = tms.FormatByMill(mem.LastLoginTime)
+	return memMsg, nil
+}
+
+// cacheMember 刷新缓存中的用户信息，与登录时写入的缓存使用相同的键和有效期
+func (ls *LoginService) cacheMember(ctx context.Context, mem *member.Member) {
+	marshal, _ := json.Marshal(mem)
+	exp := time.Duration(config.C.JwtConfig.AccessExp*3600*24) * time.Second
+	if err := ls.cache.Put(ctx, model.Member+"::"+strconv.FormatInt(mem.Id, 10), string(marshal), exp); err != nil {
+		logs.Ctx(ctx).Error("cacheMember cache put error", zap.Error(err))
+	}
+}
+
+// changeKeyPrefix 修改手机号或邮箱的验证码缓存键前缀，验证码与发起修改的用户绑定
+func changeKeyPrefix(memId int64) string {
+	return model.ChangeRedisKey + strconv.FormatInt(memId, 10) + "_"
+}
@@ -0,0 +1,40 @@
+package login_service_v1
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"project-common/errs"
+	"project-common/jwts"
+	"project-common/logs"
+	"project-grpc/user/login"
+	"project-user/config"
+	"project-user/pkg/model"
+)
+
+// RefreshToken 使用刷新令牌换取新的访问令牌和刷新令牌。
+// 刷新令牌只能使用一次，使用后立即作废（轮换）；
+// 如果一个已经作废的刷新令牌被再次提交，说明令牌可能已经泄露，
+// 此时作废整个令牌家族，该家族下所有的访问令牌和刷新令牌都会失效，用户需要重新登录。
+func (ls *LoginService) RefreshToken(ctx context.Context, msg *login.RefreshTokenMessage) (*login.TokenMessage, error) {
+	c := ctx
+	// 1. 校验刷新令牌的签名和有效期
+	claims, err := jwts.ParseClaims(msg.RefreshToken, config.C.JwtConfig.RefreshSecret)
+	if err != nil || claims.Jti == "" || claims.Family == "" {
+		logs.Ctx(ctx).Error("RefreshToken ParseClaims error", zap.Error(err))
+		return nil, errs.GrpcError(model.RefreshTokenError)
+	}
+	// 2. 令牌家族必须仍然有效并且属于同一个用户，消费刷新令牌；令牌被重复使用时整个家族已被作废
+	if bErr := ls.tokenFamily.ConsumeRefreshToken(c, claims); bErr != nil {
+		if bErr == model.RefreshTokenReused {
+			ls.invalidateMember(c, claims.Val)
+		}
+		return nil, errs.GrpcError(bErr)
+	}
+	// 3. 在同一个家族中签发新的令牌对，沿用当前选择的组织
+	tokenList, err := ls.createToken(c, claims.Val, msg.Ip, claims.Family, claims.Org)
+	if err != nil {
+		logs.Ctx(ctx).Error("RefreshToken createToken error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	return tokenList, nil
+}
@@ -0,0 +1,98 @@
+package login_service_v1
+
+import (
+	"context"
+	"go.uber.org/zap"
+	common "project-common"
+	"project-common/encrypts"
+	"project-common/errs"
+	"project-common/logs"
+	"project-grpc/user/login"
+	"project-user/config"
+	"project-user/internal/data/member"
+	"project-user/pkg/model"
+	"strconv"
+)
+
+// SendResetCode 发送找回密码的验证码。
+// account 可以是手机号或者邮箱。账号未注册时同样计入发送频率并返回成功，但不发送验证码，
+// 避免通过该接口判断手机号或邮箱是否已注册。
+func (ls *LoginService) SendResetCode(ctx context.Context, msg *login.ResetCodeMessage) (*login.CaptchaResponse, error) {
+	c := ctx
+	mem, err := ls.findMemberByMobileOrEmail(c, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if mem == nil {
+		if err = ls.checkSendLimit(c, msg.Account, msg.Ip); err != nil {
+			return nil, err
+		}
+		logs.Ctx(ctx).Info("SendResetCode account not exist")
+		return &login.CaptchaResponse{}, nil
+	}
+	code, err := ls.sendCaptcha(c, model.ResetPwdRedisKey, msg.Account, msg.Ip)
+	if err != nil {
+		return nil, err
+	}
+	logs.Ctx(ctx).Info("SendResetCode success", zap.Int64("memberId", mem.Id))
+	if !config.C.CaptchaConfig.Dev {
+		code = ""
+	}
+	return &login.CaptchaResponse{Code: code}, nil
+}
+
+// ResetPassword 使用验证码重置密码。
+// 重置成功后用户之前签发的所有令牌全部失效，需要使用新密码重新登录。
+func (ls *LoginService) ResetPassword(ctx context.Context, msg *login.ResetPasswordMessage) (*login.ResetPasswordResponse, error) {
+	c := ctx
+	// 1. 校验账号格式
+	if !common.VerifyMobile(msg.Account) && !common.VerifyEmailFormat(msg.Account) {
+		return nil, errs.GrpcError(model.NoLegalAccount)
+	}
+	// 2. 校验验证码，未注册的账号没有发送过验证码，在这里失败
+	if err := ls.verifyCaptcha(c, model.ResetPwdRedisKey, msg.Account, msg.Captcha); err != nil {
+		return nil, err
+	}
+	mem, err := ls.findMemberByMobileOrEmail(c, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if mem == nil {
+		return nil, errs.GrpcError(model.MemberNotExist)
+	}
+	// 3. 更新密码
+	pwd, err := encrypts.HashPassword(msg.Password)
+	if err != nil {
+		logs.Ctx(ctx).Error("ResetPassword HashPassword error", zap.Error(err))
+		return nil, errs.GrpcError(model.PasswordHashError)
+	}
+	if err = ls.memberRepo.UpdatePassword(c, mem.Id, pwd); err != nil {
+		logs.Ctx(ctx).Error("ResetPassword db UpdatePassword error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	// 4. 吊销该用户所有未过期的令牌
+	if err = ls.revokeAllTokens(c, strconv.FormatInt(mem.Id, 10)); err != nil {
+		logs.Ctx(ctx).Error("ResetPassword revokeAllTokens error", zap.Error(err))
+		return nil, errs.GrpcError(model.RedisError)
+	}
+	return &login.ResetPasswordResponse{}, nil
+}
+
+// findMemberByMobileOrEmail 根据手机号或邮箱查找用户，找不到时返回 nil
+func (ls *LoginService) findMemberByMobileOrEmail(ctx context.Context, account string) (*member.Member, error) {
+	var mem *member.Member
+	var err error
+	switch {
+	case common.VerifyMobile(account):
+		mem, err = ls.memberRepo.FindMemberByMobile(ctx, account)
+	case common.VerifyEmailFormat(account):
+		mem, err = ls.memberRepo.FindMemberByEmail(ctx, account)
+	default:
+		return nil, errs.GrpcError(model.NoLegalAccount)
+	}
+	if err != nil {
+		logs.Ctx(ctx).Error("findMemberByMobileOrEmail db error", zap.Error(err))
+		return nil, errs.GrpcError(model.DBError)
+	}
+	return mem, nil
+}
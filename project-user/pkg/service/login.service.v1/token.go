@@ -0,0 +1,115 @@
+package login_service_v1
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"project-common/jwts"
+	"project-common/logs"
+	"project-grpc/user/login"
+	"project-user/config"
+	"project-user/pkg/model"
+	"time"
+)
+
+// createToken 签发访问令牌和刷新令牌，并在缓存中登记刷新令牌和令牌家族。
+// family 为空表示一次新的登录，org 为令牌中携带的当前组织。
+func (ls *LoginService) createToken(ctx context.Context, memIdStr string, ip string, family string, org int64) (*login.TokenMessage, error) {
+	// 计算访问令牌和刷新令牌的过期时间
+	exp := time.Duration(config.C.JwtConfig.AccessExp*3600*24) * time.Second
+	rExp := time.Duration(config.C.JwtConfig.RefreshExp*3600*24) * time.Second
+	version, err := ls.tokenFamily.TokenVersion(ctx, memIdStr)
+	if err != nil {
+		return nil, err
+	}
+	opt := jwts.TokenOption{Ip: ip, Family: family, Version: version, Org: org}
+	token, err := jwts.CreateTokenWithKeySet(memIdStr, exp, config.C.JwtConfig.KeySet, rExp, config.C.JwtConfig.RefreshSecret, opt)
+	if err != nil {
+		return nil, err
+	}
+	// 令牌家族与刷新令牌同时过期，每次轮换都会延长家族的有效期
+	err = ls.cache.Put(ctx, model.TokenFamily+"::"+token.Family, memIdStr, rExp)
+	if err != nil {
+		return nil, err
+	}
+	err = ls.cache.Put(ctx, model.RefreshToken+"::"+token.RefreshId, token.Family, rExp)
+	if err != nil {
+		return nil, err
+	}
+	return &login.TokenMessage{
+		AccessToken:    token.AccessToken,
+		RefreshToken:   token.RefreshToken,
+		AccessTokenExp: token.AccessExp,
+		TokenType:      "bearer",
+	}, nil
+}
+
+// tokenRevoked 判断访问令牌是否已经被吊销：
+// jti 在吊销列表中、所属的令牌家族已经作废或者令牌版本已经过期都视为吊销
+func (ls *LoginService) tokenRevoked(ctx context.Context, claims *jwts.TokenClaims) (bool, error) {
+	if claims.Jti != "" {
+		_, err := ls.cache.Get(ctx, model.RevokedToken+"::"+claims.Jti)
+		if err == nil {
+			return true, nil
+		}
+		if err != redis.Nil {
+			return false, err
+		}
+	}
+	if claims.Family != "" {
+		alive, err := ls.tokenFamily.FamilyAlive(ctx, claims.Family)
+		if err != nil || !alive {
+			return !alive, err
+		}
+	}
+	valid, err := ls.tokenFamily.VersionValid(ctx, claims)
+	if err != nil {
+		return false, err
+	}
+	return !valid, nil
+}
+
+// revokeToken 将令牌的 jti 加入吊销列表，直到令牌自然过期
+func (ls *LoginService) revokeToken(ctx context.Context, claims *jwts.TokenClaims) error {
+	if claims.Jti == "" {
+		return nil
+	}
+	ttl := time.Until(time.Unix(claims.Exp, 0))
+	if ttl <= 0 {
+		return nil
+	}
+	if err := ls.cache.Put(ctx, model.RevokedToken+"::"+claims.Jti, claims.Val, ttl); err != nil {
+		return err
+	}
+	ls.invalidateMember(ctx, claims.Val)
+	return nil
+}
+
+// revokeFamily 吊销访问令牌并作废其所属的令牌家族，同一次登录的刷新令牌随之失效
+func (ls *LoginService) revokeFamily(ctx context.Context, claims *jwts.TokenClaims) error {
+	if err := ls.revokeToken(ctx, claims); err != nil {
+		return err
+	}
+	if claims.Family == "" {
+		return nil
+	}
+	_, err := ls.cache.Del(ctx, model.TokenFamily+"::"+claims.Family)
+	return err
+}
+
+// revokeAllTokens 递增用户的令牌版本，之前签发的所有令牌全部失效
+func (ls *LoginService) revokeAllTokens(ctx context.Context, memIdStr string) error {
+	if _, err := ls.cache.Incr(ctx, model.TokenVersion+"::"+memIdStr); err != nil {
+		return err
+	}
+	ls.invalidateMember(ctx, memIdStr)
+	return nil
+}
+
+// invalidateMember 通知网关丢弃该成员的令牌校验缓存，令牌吊销、个人资料或组织变化后调用。
+// 发布失败只记录日志，网关的缓存有效期很短，最迟在有效期结束后恢复一致。
+func (ls *LoginService) invalidateMember(ctx context.Context, memIdStr string) {
+	if err := ls.cache.Publish(ctx, model.AuthInvalidate, memIdStr); err != nil {
+		logs.Ctx(ctx).Error("invalidateMember cache publish error", zap.String("memberId", memIdStr), zap.Error(err))
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"net"
 	"project-common/discovery"
 	"project-common/logs"
+	"project-common/metrics"
+	"project-common/tracing"
 	"project-grpc/user/login"
 	"project-user/config"
 	loginServiceV1 "project-user/pkg/service/login.service.v1"
@@ -61,7 +63,8 @@ func RegisterGrpc() *grpc.Server {
 		RegisterFunc: func(g *grpc.Server) {
 			login.RegisterLoginServiceServer(g, loginServiceV1.New())
 		}}
-	s := grpc.NewServer()
+	// 从 metadata 中恢复网关传递的链路追踪信息
+	s := grpc.NewServer(append(tracing.ServerOptions(), metrics.UnaryServerInterceptor())...)
 	c.RegisterFunc(s)
 	lis, err := net.Listen("tcp", c.Addr)
 	if err != nil {